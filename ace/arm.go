@@ -0,0 +1,231 @@
+package ace
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+var (
+	armDataOps = []string{
+		"and", "eor", "sub", "rsb", "add", "adc", "sbc", "rsc",
+		"tst", "teq", "cmp", "cmn", "orr", "mov", "bic", "mvn",
+	}
+	armShiftOps = []string{"lsl", "lsr", "asr", "ror"}
+	armBlockOps = []string{"da", "ia", "db", "ib"}
+)
+
+// The shifted register form of operand 2 and of single transfer offsets
+func armShiftedReg(op uint32) string {
+	rm := regName(op & 15)
+	typ := (op >> 5) & 3
+
+	if op&(1<<4) != 0 {
+		return fmt.Sprintf("%s, %s %s", rm, armShiftOps[typ], regName((op>>8)&15))
+	}
+
+	amount := (op >> 7) & 31
+	switch {
+	case amount == 0 && typ == 0:
+		return rm
+	case amount == 0 && typ == 3:
+		return rm + ", rrx"
+	case amount == 0:
+		// LSR and ASR #0 mean #32
+		amount = 32
+	}
+	return fmt.Sprintf("%s, %s #%d", rm, armShiftOps[typ], amount)
+}
+
+func armDataProcessing(op uint32, cond string) string {
+	code := (op >> 21) & 15
+	rn := regName((op >> 16) & 15)
+	rd := regName((op >> 12) & 15)
+
+	var op2 string
+	if op&(1<<25) != 0 {
+		op2 = fmt.Sprintf("#0x%X", bits.RotateLeft32(op&0xFF, -int((op>>8)&15)*2))
+	} else {
+		op2 = armShiftedReg(op)
+	}
+
+	name := armDataOps[code] + cond
+	switch {
+	case code >= 8 && code <= 11:
+		// Compares always set flags, the S bit is implied
+		return fmt.Sprintf("%s %s, %s", name, rn, op2)
+	case op&(1<<20) != 0:
+		name += "s"
+	}
+
+	if code == 13 || code == 15 {
+		return fmt.Sprintf("%s %s, %s", name, rd, op2)
+	}
+	return fmt.Sprintf("%s %s, %s, %s", name, rd, rn, op2)
+}
+
+func armTransfer(op uint32, cond string, offset string) string {
+	name := "str"
+	if op&(1<<20) != 0 {
+		name = "ldr"
+	}
+
+	rd := regName((op >> 12) & 15)
+	rn := regName((op >> 16) & 15)
+
+	sign := ""
+	if op&(1<<23) == 0 {
+		sign = "-"
+	}
+
+	var addr string
+	switch {
+	case op&(1<<24) == 0:
+		addr = fmt.Sprintf("[%s], %s%s", rn, sign, offset)
+	case op&(1<<21) != 0:
+		addr = fmt.Sprintf("[%s, %s%s]!", rn, sign, offset)
+	default:
+		addr = fmt.Sprintf("[%s, %s%s]", rn, sign, offset)
+	}
+
+	return fmt.Sprintf("%s%s %s, %s", name, cond, rd, addr)
+}
+
+// DecodeARM gives the text for one ARMv4T word at addr
+func DecodeARM(op, addr uint32) string {
+	cond := condNames[op>>28]
+
+	switch {
+	case op&0x0FFFFFF0 == 0x012FFF10:
+		return fmt.Sprintf("bx%s %s", cond, regName(op&15))
+
+	case op&0x0E000000 == 0x0A000000:
+		name := "b"
+		if op&(1<<24) != 0 {
+			name = "bl"
+		}
+		target := int64(addr) + 8 + int64(signExtend(op&0xFFFFFF, 24))*4
+		return fmt.Sprintf("%s%s 0x%X", name, cond, uint32(target))
+
+	case op&0x0F000000 == 0x0F000000:
+		return fmt.Sprintf("swi%s #0x%X", cond, op&0xFFFFFF)
+
+	case op&0x0FC000F0 == 0x00000090:
+		rd := regName((op >> 16) & 15)
+		rm := regName(op & 15)
+		rs := regName((op >> 8) & 15)
+		s := ""
+		if op&(1<<20) != 0 {
+			s = "s"
+		}
+		if op&(1<<21) != 0 {
+			return fmt.Sprintf("mla%s%s %s, %s, %s, %s", cond, s, rd, rm, rs, regName((op>>12)&15))
+		}
+		return fmt.Sprintf("mul%s%s %s, %s, %s", cond, s, rd, rm, rs)
+
+	case op&0x0F8000F0 == 0x00800090:
+		names := []string{"umull", "umlal", "smull", "smlal"}
+		s := ""
+		if op&(1<<20) != 0 {
+			s = "s"
+		}
+		return fmt.Sprintf("%s%s%s %s, %s, %s, %s", names[(op>>21)&3], cond, s,
+			regName((op>>12)&15), regName((op>>16)&15), regName(op&15), regName((op>>8)&15))
+
+	case op&0x0FB00FF0 == 0x01000090:
+		b := ""
+		if op&(1<<22) != 0 {
+			b = "b"
+		}
+		return fmt.Sprintf("swp%s%s %s, %s, [%s]", cond, b,
+			regName((op>>12)&15), regName(op&15), regName((op>>16)&15))
+
+	// Halfword and signed transfers
+	case op&0x0E000090 == 0x00000090 && (op>>5)&3 != 0:
+		var offset string
+		if op&(1<<22) != 0 {
+			offset = fmt.Sprintf("#%d", (op>>4)&0xF0|op&15)
+		} else {
+			offset = regName(op & 15)
+		}
+
+		s := armTransfer(op, cond, offset)
+		suffix := []string{"", "h", "sb", "sh"}[(op>>5)&3]
+		return strings.Replace(s, cond+" ", cond+suffix+" ", 1)
+
+	case op&0x0FBF0FFF == 0x010F0000:
+		psr := "cpsr"
+		if op&(1<<22) != 0 {
+			psr = "spsr"
+		}
+		return fmt.Sprintf("mrs%s %s, %s", cond, regName((op>>12)&15), psr)
+
+	case op&0x0DB0F000 == 0x0120F000:
+		psr := "cpsr"
+		if op&(1<<22) != 0 {
+			psr = "spsr"
+		}
+		fields := ""
+		for i, f := range "cxsf" {
+			if op&(1<<(16+i)) != 0 {
+				fields += string(f)
+			}
+		}
+
+		src := regName(op & 15)
+		if op&(1<<25) != 0 {
+			src = fmt.Sprintf("#0x%X", bits.RotateLeft32(op&0xFF, -int((op>>8)&15)*2))
+		}
+		return fmt.Sprintf("msr%s %s_%s, %s", cond, psr, fields, src)
+
+	case op&0x0C000000 == 0x00000000:
+		return armDataProcessing(op, cond)
+
+	// Single data transfer, a register offset can't use a register shift
+	case op&0x0C000000 == 0x04000000 && op&0x02000010 != 0x02000010:
+		offset := fmt.Sprintf("#%d", op&0xFFF)
+		if op&(1<<25) != 0 {
+			offset = armShiftedReg(op)
+		}
+
+		s := armTransfer(op, cond, offset)
+		if op&(1<<22) != 0 {
+			s = strings.Replace(s, cond+" ", cond+"b ", 1)
+		}
+		return s
+
+	case op&0x0E000000 == 0x08000000:
+		name := "stm"
+		if op&(1<<20) != 0 {
+			name = "ldm"
+		}
+
+		wb := ""
+		if op&(1<<21) != 0 {
+			wb = "!"
+		}
+
+		psr := ""
+		if op&(1<<22) != 0 {
+			psr = "^"
+		}
+
+		return fmt.Sprintf("%s%s%s %s%s, %s%s", name, cond, armBlockOps[(op>>23)&3],
+			regName((op>>16)&15), wb, regList(op&0xFFFF, ""), psr)
+	}
+
+	return fmt.Sprintf(".word 0x%08X", op)
+}
+
+func disassembleARM(data []byte, base uint32) []Line {
+	var out []Line
+
+	for off := 0; off+3 < len(data); off += 4 {
+		addr := base + uint32(off)
+		op := binary.LittleEndian.Uint32(data[off:])
+		out = append(out, Line{Offset: off, Addr: addr, Bytes: data[off : off+4], Text: DecodeARM(op, addr)})
+	}
+
+	return out
+}
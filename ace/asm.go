@@ -0,0 +1,856 @@
+package ace
+
+import (
+	"encoding/binary"
+	"fmt"
+	"postal/utils"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Payload is assembled code laid out over the box names
+type Payload struct {
+	Names []byte
+	Lines []Line
+}
+
+// Name is box i's 9 bytes
+func (p *Payload) Name(i int) []byte {
+	return p.Names[i*NameSize : (i+1)*NameSize]
+}
+
+// Used is how many names the code reaches into, the rest can keep
+// whatever they're called
+func (p *Payload) Used() int {
+	if len(p.Lines) == 0 {
+		return 0
+	}
+
+	last := p.Lines[len(p.Lines)-1]
+	return (last.Offset+len(last.Bytes)-1)/NameSize + 1
+}
+
+type asmItem struct {
+	line  int
+	mnem  string
+	args  []string
+	size  int
+	off   int
+	label bool
+}
+
+type assembler struct {
+	base   uint32
+	filler uint32
+	labels map[string]uint32
+	items  []asmItem
+	out    Payload
+}
+
+// Instructions that never run into whatever follows them
+func (it *asmItem) endsFlow() bool {
+	switch it.mnem {
+	case "b", "bx":
+		return true
+	case "pop":
+		return len(it.args) == 1 && strings.Contains(it.args[0], "pc")
+	}
+	return false
+}
+
+// Assemble packs THUMB source into the box names, starting at the first
+// byte of box 1 which sits at base. The terminators are worked around:
+// one closing an even box is covered by an add into the filler register
+// (r7 unless .filler picks another), and the halfword holding one that
+// closes an odd box is jumped over. Every other byte has to be one the
+// naming screen can type, and bytes past the end of the code end names
+// early
+func Assemble(src string, base uint32) (Payload, error) {
+	a := assembler{
+		base:   base,
+		filler: 7,
+		labels: make(map[string]uint32),
+		out: Payload{
+			Names: slices.Repeat([]byte{Terminator}, PayloadSize),
+		},
+	}
+
+	if err := a.parse(src); err != nil {
+		return Payload{}, err
+	}
+
+	if err := a.layout(); err != nil {
+		return Payload{}, err
+	}
+
+	if err := a.encode(); err != nil {
+		return Payload{}, err
+	}
+
+	return a.out, nil
+}
+
+func lineError(line int, err error) error {
+	return fmt.Errorf("line %d: %w", line, err)
+}
+
+func stripComment(s string) string {
+	for _, c := range []string{"@", ";", "//"} {
+		if i := strings.Index(s, c); i >= 0 {
+			s = s[:i]
+		}
+	}
+	return strings.TrimSpace(s)
+}
+
+// Splits operands on the commas outside brackets and braces
+func splitArgs(s string) []string {
+	var args []string
+	depth, start := 0, 0
+
+	for i, c := range s {
+		switch c {
+		case '[', '{':
+			depth++
+		case ']', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+
+	if rest := strings.TrimSpace(s[start:]); rest != "" || len(args) > 0 {
+		args = append(args, rest)
+	}
+	return args
+}
+
+func (a *assembler) parse(src string) error {
+	for n, l := range strings.Split(src, "\n") {
+		l = stripComment(l)
+
+		for {
+			i := strings.Index(l, ":")
+			if i < 0 || strings.ContainsAny(l[:i], " \t[{#") {
+				break
+			}
+
+			name := l[:i]
+			if name == "" {
+				return lineError(n+1, utils.ErrAsmSyntax)
+			}
+			a.items = append(a.items, asmItem{line: n + 1, mnem: name, label: true})
+			l = strings.TrimSpace(l[i+1:])
+		}
+
+		if l == "" {
+			continue
+		}
+
+		mnem, rest, _ := strings.Cut(l, " ")
+		mnem = strings.ToLower(mnem)
+		args := splitArgs(strings.TrimSpace(rest))
+
+		if mnem == ".filler" {
+			if len(args) != 1 {
+				return lineError(n+1, utils.ErrAsmSyntax)
+			}
+
+			r, err := parseLowReg(args[0])
+			if err != nil {
+				return lineError(n+1, err)
+			}
+			a.filler = r
+			continue
+		}
+
+		size := 2
+		if mnem == "bl" || mnem == ".word" {
+			size = 4
+		}
+		a.items = append(a.items, asmItem{line: n + 1, mnem: mnem, args: args, size: size})
+	}
+
+	return nil
+}
+
+// Halfword starts that hold the terminator of an odd box in their high
+// byte. These decode as the second half of a BL so they can't be run
+func isHighStop(off int) bool {
+	return IsTerminator(off + 1)
+}
+
+func (a *assembler) emit(off int, hw uint16) {
+	binary.LittleEndian.PutUint16(a.out.Names[off:], hw)
+	text, _ := DecodeThumb(hw, 0, a.base+uint32(off))
+	a.out.Lines = append(a.out.Lines, Line{
+		Offset: off,
+		Addr:   a.base + uint32(off),
+		Bytes:  a.out.Names[off : off+2],
+		Text:   text,
+	})
+}
+
+// The filler covering an even box's terminator doubles as a nop with
+// the offset set to a space
+func (a *assembler) fillerOp(imm byte) uint16 {
+	if a.filler == 0 {
+		// add r0, pc isn't typeable but add r0, sp is
+		return 0xA800 | uint16(imm)
+	}
+	return 0xA000 | uint16(a.filler)<<8 | uint16(imm)
+}
+
+const (
+	skipOp = 0xE000 // b over the next halfword
+	stopOp = 0xFF00 // the halfword jumped over, last letter a space
+)
+
+// Halfword that has to go at pos before it can take the item, if any
+func (a *assembler) padding(pos int, it *asmItem) (uint16, bool) {
+	switch {
+	case IsTerminator(pos):
+		return a.fillerOp(Terminator), true
+	case isHighStop(pos):
+		return stopOp, true
+
+	// BL and .word can't be split by a name's end
+	case it.size == 4 && isHighStop(pos+2):
+		return skipOp, true
+	case it.size == 4 && IsTerminator(pos+2):
+		return a.fillerOp(0), true
+
+	// Running on into a stop has to jump it right before
+	case isHighStop(pos+it.size) && !it.endsFlow():
+		if it.size == 2 {
+			return skipOp, true
+		}
+		return a.fillerOp(0), true
+	}
+	return 0, false
+}
+
+func (a *assembler) layout() error {
+	pos := 0
+	var pending []string
+
+	for i := range a.items {
+		it := &a.items[i]
+		if it.label {
+			if _, ok := a.labels[it.mnem]; ok || slices.Contains(pending, it.mnem) {
+				return lineError(it.line, fmt.Errorf("%w: label %q defined twice", utils.ErrAsmSyntax, it.mnem))
+			}
+			pending = append(pending, it.mnem)
+			continue
+		}
+
+		for {
+			if pos+it.size > PayloadSize {
+				return lineError(it.line, utils.ErrAsmTooLong)
+			}
+
+			hw, ok := a.padding(pos, it)
+			if !ok {
+				break
+			}
+			a.emit(pos, hw)
+			pos += 2
+		}
+
+		// Labels point past any padding, at the item itself
+		for _, l := range pending {
+			a.labels[l] = a.base + uint32(pos)
+		}
+		pending = pending[:0]
+
+		it.off = pos
+		pos += it.size
+	}
+
+	for _, l := range pending {
+		a.labels[l] = a.base + uint32(pos)
+	}
+	return nil
+}
+
+func (a *assembler) encode() error {
+	for _, it := range a.items {
+		if it.label {
+			continue
+		}
+
+		addr := a.base + uint32(it.off)
+		hws, err := a.encodeItem(&it, addr)
+		if err != nil {
+			return lineError(it.line, err)
+		}
+
+		for j, hw := range hws {
+			off := it.off + j*2
+			for k, b := range []byte{byte(hw), byte(hw >> 8)} {
+				if !Typeable(b) {
+					return lineError(it.line, fmt.Errorf("%w: %s encodes to %04X, 0x%02X at box %d letter %d",
+						utils.ErrAsmUntypeable, it.mnem, hw, b, (off+k)/NameSize+1, (off+k)%NameSize+1))
+				}
+			}
+			binary.LittleEndian.PutUint16(a.out.Names[off:], hw)
+		}
+
+		text, _ := DecodeThumb(hws[0], 0, addr)
+		if it.mnem == "bl" {
+			text, _ = DecodeThumb(hws[0], hws[1], addr)
+		} else if it.mnem == ".word" {
+			text = fmt.Sprintf(".word 0x%04X%04X", hws[1], hws[0])
+		}
+
+		a.out.Lines = append(a.out.Lines, Line{
+			Offset: it.off,
+			Addr:   addr,
+			Bytes:  a.out.Names[it.off : it.off+it.size],
+			Text:   text,
+			Source: it.line,
+		})
+	}
+
+	slices.SortFunc(a.out.Lines, func(x, y Line) int { return x.Offset - y.Offset })
+	return nil
+}
+
+func parseReg(s string) (uint32, error) {
+	switch s = strings.ToLower(strings.TrimSpace(s)); s {
+	case "sp":
+		return 13, nil
+	case "lr":
+		return 14, nil
+	case "pc":
+		return 15, nil
+	}
+
+	if !strings.HasPrefix(s, "r") {
+		return 0, utils.ErrAsmSyntax
+	}
+
+	r, err := strconv.ParseUint(s[1:], 10, 8)
+	if err != nil || r > 15 {
+		return 0, utils.ErrAsmSyntax
+	}
+	return uint32(r), nil
+}
+
+func parseLowReg(s string) (uint32, error) {
+	r, err := parseReg(s)
+	if err != nil {
+		return 0, err
+	}
+	if r > 7 {
+		return 0, utils.ErrOutOfRange
+	}
+	return r, nil
+}
+
+func isReg(s string) bool {
+	_, err := parseReg(s)
+	return err == nil
+}
+
+func parseNum(s string) (int64, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "#")
+	v, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		u, uerr := strconv.ParseUint(s, 0, 32)
+		if uerr != nil {
+			return 0, utils.ErrAsmSyntax
+		}
+		return int64(u), nil
+	}
+	return v, nil
+}
+
+// Immediate that has to fit bits after dividing by scale
+func parseImm(s string, bits uint, scale int64) (uint32, error) {
+	v, err := parseNum(s)
+	if err != nil {
+		return 0, err
+	}
+	if v < 0 || v%scale != 0 || v/scale >= 1<<bits {
+		return 0, utils.ErrOutOfRange
+	}
+	return uint32(v / scale), nil
+}
+
+func (a *assembler) target(s string) (uint32, error) {
+	if v, ok := a.labels[strings.TrimSpace(s)]; ok {
+		return v, nil
+	}
+
+	v, err := parseNum(s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: unknown label %q", err, strings.TrimSpace(s))
+	}
+	return uint32(v), nil
+}
+
+// Branch offset in halfwords from the pipelined pc, checked to fit bits
+func (a *assembler) branch(s string, addr uint32, bits uint) (uint32, error) {
+	t, err := a.target(s)
+	if err != nil {
+		return 0, err
+	}
+
+	off := (int64(t) - int64(addr) - 4) / 2
+	if t%2 != 0 || off < -(1<<(bits-1)) || off >= 1<<(bits-1) {
+		return 0, utils.ErrOutOfRange
+	}
+	return uint32(off) & (1<<bits - 1), nil
+}
+
+func parseRegList(s string) (uint32, []string, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "{") || !strings.HasSuffix(s, "}") {
+		return 0, nil, utils.ErrAsmSyntax
+	}
+
+	var mask uint32
+	var hi []string
+
+	// An empty list is what the disassembler shows for a mask of 0, which
+	// the GBA runs as a transfer of pc alone
+	if strings.TrimSpace(s[1:len(s)-1]) == "" {
+		return 0, nil, nil
+	}
+
+	for _, r := range strings.Split(s[1:len(s)-1], ",") {
+		r = strings.ToLower(strings.TrimSpace(r))
+		if r == "lr" || r == "pc" {
+			hi = append(hi, r)
+			continue
+		}
+
+		from, to, isRange := strings.Cut(r, "-")
+		lo, err := parseLowReg(from)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		end := lo
+		if isRange {
+			if end, err = parseLowReg(to); err != nil {
+				return 0, nil, err
+			}
+		}
+
+		for i := lo; i <= end; i++ {
+			mask |= 1 << i
+		}
+	}
+
+	return mask, hi, nil
+}
+
+// Memory operand like [rb, ro], [rb, #imm] or [rb]
+func parseMem(s string) (uint32, string, bool, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "[") || !strings.HasSuffix(s, "]") {
+		return 0, "", false, utils.ErrAsmSyntax
+	}
+
+	parts := splitArgs(s[1 : len(s)-1])
+	if len(parts) == 0 || len(parts) > 2 {
+		return 0, "", false, utils.ErrAsmSyntax
+	}
+
+	rb, err := parseReg(parts[0])
+	if err != nil {
+		return 0, "", false, err
+	}
+
+	if len(parts) == 1 {
+		return rb, "#0", false, nil
+	}
+	return rb, parts[1], isReg(parts[1]), nil
+}
+
+func (a *assembler) argCount(it *asmItem, n int) error {
+	if len(it.args) != n {
+		return utils.ErrAsmSyntax
+	}
+	return nil
+}
+
+func (a *assembler) encodeItem(it *asmItem, addr uint32) ([]uint16, error) {
+	one := func(hw uint32, err error) ([]uint16, error) {
+		return []uint16{uint16(hw)}, err
+	}
+
+	args := it.args
+
+	switch m := it.mnem; m {
+	case ".hword":
+		if err := a.argCount(it, 1); err != nil {
+			return nil, err
+		}
+		return one(parseImm(args[0], 16, 1))
+
+	case ".word":
+		if err := a.argCount(it, 1); err != nil {
+			return nil, err
+		}
+		v, err := a.target(args[0])
+		return []uint16{uint16(v), uint16(v >> 16)}, err
+
+	case "lsl", "lsr", "asr":
+		return one(a.encodeShift(it))
+
+	case "add", "sub":
+		return one(a.encodeAddSub(it))
+
+	case "mov", "cmp":
+		return one(a.encodeMovCmp(it))
+
+	case "and", "eor", "adc", "sbc", "ror", "tst", "neg", "cmn", "orr", "mul", "bic", "mvn":
+		if err := a.argCount(it, 2); err != nil {
+			return nil, err
+		}
+		rd, err1 := parseLowReg(args[0])
+		rs, err2 := parseLowReg(args[1])
+		op := slices.Index(thumbALUOps, m)
+		return one(0x4000|uint32(op)<<6|rs<<3|rd, firstErr(err1, err2))
+
+	case "bx":
+		if err := a.argCount(it, 1); err != nil {
+			return nil, err
+		}
+		rs, err := parseReg(args[0])
+		return one(0x4700|rs<<3, err)
+
+	case "ldr", "str", "ldrb", "strb", "ldrh", "strh", "ldsb", "ldsh", "ldrsb", "ldrsh":
+		return one(a.encodeTransfer(it, addr))
+
+	case "push", "pop":
+		if err := a.argCount(it, 1); err != nil {
+			return nil, err
+		}
+		mask, hi, err := parseRegList(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		want, op := "lr", uint32(0xB400)
+		if m == "pop" {
+			want, op = "pc", 0xBC00
+		}
+		for _, r := range hi {
+			if r != want {
+				return nil, utils.ErrAsmSyntax
+			}
+			op |= 1 << 8
+		}
+		return one(op|mask, nil)
+
+	case "stmia", "ldmia":
+		if err := a.argCount(it, 2); err != nil {
+			return nil, err
+		}
+		rb, err := parseLowReg(strings.TrimSuffix(args[0], "!"))
+		if err != nil {
+			return nil, err
+		}
+		mask, hi, err := parseRegList(args[1])
+		if err != nil || len(hi) > 0 {
+			return nil, firstErr(err, utils.ErrAsmSyntax)
+		}
+
+		op := uint32(0xC000)
+		if m == "ldmia" {
+			op |= 1 << 11
+		}
+		return one(op|rb<<8|mask, nil)
+
+	case "swi":
+		if err := a.argCount(it, 1); err != nil {
+			return nil, err
+		}
+		v, err := parseImm(args[0], 8, 1)
+		return one(0xDF00|v, err)
+
+	case "b":
+		if err := a.argCount(it, 1); err != nil {
+			return nil, err
+		}
+		off, err := a.branch(args[0], addr, 11)
+		return one(0xE000|off, err)
+
+	case "bl":
+		if err := a.argCount(it, 1); err != nil {
+			return nil, err
+		}
+		off, err := a.branch(args[0], addr, 22)
+		return []uint16{uint16(0xF000 | off>>11), uint16(0xF800 | off&0x7FF)}, err
+	}
+
+	// Conditional branches
+	if c := slices.Index(condNames, strings.TrimPrefix(it.mnem, "b")); strings.HasPrefix(it.mnem, "b") && c >= 0 && c < 14 {
+		if err := a.argCount(it, 1); err != nil {
+			return nil, err
+		}
+		off, err := a.branch(args[0], addr, 8)
+		return one(0xD000|uint32(c)<<8|off, err)
+	}
+
+	return nil, fmt.Errorf("%w: unknown instruction %q", utils.ErrAsmSyntax, it.mnem)
+}
+
+func firstErr(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *assembler) encodeShift(it *asmItem) (uint32, error) {
+	op := uint32(slices.Index(thumbShiftOps, it.mnem))
+
+	switch len(it.args) {
+	case 2:
+		// Register shift goes through the ALU ops
+		rd, err1 := parseLowReg(it.args[0])
+		rs, err2 := parseLowReg(it.args[1])
+		alu := uint32(slices.Index(thumbALUOps, it.mnem))
+		return 0x4000 | alu<<6 | rs<<3 | rd, firstErr(err1, err2)
+
+	case 3:
+		rd, err1 := parseLowReg(it.args[0])
+		rs, err2 := parseLowReg(it.args[1])
+		if err := firstErr(err1, err2); err != nil {
+			return 0, err
+		}
+
+		n, err := parseNum(it.args[2])
+		if err != nil {
+			return 0, err
+		}
+
+		// LSR and ASR by 32 are written as 0
+		if op != 0 && n == 32 {
+			n = 0
+		}
+		if n < 0 || n > 31 {
+			return 0, utils.ErrOutOfRange
+		}
+		return op<<11 | uint32(n)<<6 | rs<<3 | rd, nil
+	}
+
+	return 0, utils.ErrAsmSyntax
+}
+
+func (a *assembler) encodeAddSub(it *asmItem) (uint32, error) {
+	args := it.args
+	sub := uint32(0)
+	if it.mnem == "sub" {
+		sub = 1
+	}
+
+	switch len(args) {
+	case 2:
+		rd, err := parseReg(args[0])
+		if err != nil {
+			return 0, err
+		}
+
+		// add/sub sp, #imm
+		if rd == 13 && !isReg(args[1]) {
+			v, err := parseImm(args[1], 7, 4)
+			return 0xB000 | sub<<7 | v, err
+		}
+
+		if !isReg(args[1]) {
+			if rd > 7 {
+				return 0, utils.ErrOutOfRange
+			}
+			v, err := parseImm(args[1], 8, 1)
+			return 0x3000 | sub<<11 | rd<<8 | v, err
+		}
+
+		rs, _ := parseReg(args[1])
+		if rd < 8 && rs < 8 {
+			// Low registers go through the three operand form
+			return 0x1800 | sub<<9 | rs<<6 | rd<<3 | rd, nil
+		}
+		if sub == 1 {
+			return 0, utils.ErrAsmSyntax
+		}
+		return 0x4400 | (rd>>3)<<7 | (rs>>3)<<6 | (rs&7)<<3 | rd&7, nil
+
+	case 3:
+		rd, err := parseLowReg(args[0])
+		if err != nil {
+			return 0, err
+		}
+
+		rs, err := parseReg(args[1])
+		if err != nil {
+			return 0, err
+		}
+
+		// Load address from pc or sp
+		if rs == 13 || rs == 15 {
+			if sub == 1 {
+				return 0, utils.ErrAsmSyntax
+			}
+			v, err := parseImm(args[2], 8, 4)
+			sp := uint32(0)
+			if rs == 13 {
+				sp = 1
+			}
+			return 0xA000 | sp<<11 | rd<<8 | v, err
+		}
+
+		if rs > 7 {
+			return 0, utils.ErrOutOfRange
+		}
+
+		if isReg(args[2]) {
+			rn, err := parseLowReg(args[2])
+			return 0x1800 | sub<<9 | rn<<6 | rs<<3 | rd, err
+		}
+
+		v, err := parseImm(args[2], 3, 1)
+		return 0x1C00 | sub<<9 | v<<6 | rs<<3 | rd, err
+	}
+
+	return 0, utils.ErrAsmSyntax
+}
+
+func (a *assembler) encodeMovCmp(it *asmItem) (uint32, error) {
+	if err := a.argCount(it, 2); err != nil {
+		return 0, err
+	}
+
+	rd, err := parseReg(it.args[0])
+	if err != nil {
+		return 0, err
+	}
+
+	cmp := it.mnem == "cmp"
+
+	if !isReg(it.args[1]) {
+		if rd > 7 {
+			return 0, utils.ErrOutOfRange
+		}
+		v, err := parseImm(it.args[1], 8, 1)
+		op := uint32(0x2000)
+		if cmp {
+			op = 0x2800
+		}
+		return op | rd<<8 | v, err
+	}
+
+	rs, _ := parseReg(it.args[1])
+	if rd < 8 && rs < 8 {
+		if cmp {
+			return 0x4280 | rs<<3 | rd, nil
+		}
+		// mov between low registers is add #0
+		return 0x1C00 | rs<<3 | rd, nil
+	}
+
+	op := uint32(0x4600)
+	if cmp {
+		op = 0x4500
+	}
+	return op | (rd>>3)<<7 | (rs>>3)<<6 | (rs&7)<<3 | rd&7, nil
+}
+
+func (a *assembler) encodeTransfer(it *asmItem, addr uint32) (uint32, error) {
+	if err := a.argCount(it, 2); err != nil {
+		return 0, err
+	}
+
+	m := strings.Replace(it.mnem, "ldrs", "lds", 1)
+	load := uint32(0)
+	if strings.HasPrefix(m, "ld") {
+		load = 1
+	}
+
+	rd, err := parseLowReg(it.args[0])
+	if err != nil {
+		return 0, err
+	}
+
+	// ldr rd, label reads from a pc relative address
+	if !strings.HasPrefix(it.args[1], "[") {
+		if m != "ldr" {
+			return 0, utils.ErrAsmSyntax
+		}
+
+		t, err := a.target(it.args[1])
+		if err != nil {
+			return 0, err
+		}
+
+		off := int64(t) - int64((addr+4)&^3)
+		if off < 0 || off%4 != 0 || off/4 > 0xFF {
+			return 0, utils.ErrOutOfRange
+		}
+		return 0x4800 | rd<<8 | uint32(off/4), nil
+	}
+
+	rb, offset, regOffset, err := parseMem(it.args[1])
+	if err != nil {
+		return 0, err
+	}
+
+	if regOffset {
+		ro, err1 := parseLowReg(offset)
+		if err := firstErr(err1, lowReg(rb)); err != nil {
+			return 0, err
+		}
+
+		ops := map[string]uint32{
+			"str": 0x5000, "strb": 0x5400, "ldr": 0x5800, "ldrb": 0x5C00,
+			"strh": 0x5200, "ldsb": 0x5600, "ldrh": 0x5A00, "ldsh": 0x5E00,
+		}
+		return ops[m] | ro<<6 | rb<<3 | rd, nil
+	}
+
+	switch {
+	case (rb == 13 || rb == 15) && (m == "ldr" || m == "str"):
+		if rb == 15 && load == 0 {
+			return 0, utils.ErrAsmSyntax
+		}
+
+		v, err := parseImm(offset, 8, 4)
+		if rb == 15 {
+			return 0x4800 | rd<<8 | v, err
+		}
+		return 0x9000 | load<<11 | rd<<8 | v, err
+
+	case m == "ldr" || m == "str":
+		v, err := parseImm(offset, 5, 4)
+		return 0x6000 | load<<11 | v<<6 | rb<<3 | rd, firstErr(err, lowReg(rb))
+
+	case m == "ldrb" || m == "strb":
+		v, err := parseImm(offset, 5, 1)
+		return 0x7000 | load<<11 | v<<6 | rb<<3 | rd, firstErr(err, lowReg(rb))
+
+	case m == "ldrh" || m == "strh":
+		v, err := parseImm(offset, 5, 2)
+		return 0x8000 | load<<11 | v<<6 | rb<<3 | rd, firstErr(err, lowReg(rb))
+	}
+
+	// Sign extending loads only take a register offset
+	return 0, utils.ErrAsmSyntax
+}
+
+func lowReg(r uint32) error {
+	if r > 7 {
+		return utils.ErrOutOfRange
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package ace
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"postal/utils"
+	"testing"
+)
+
+const testBase = 0x02000000
+
+func TestAssembleRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		src   string
+		bytes []byte
+	}{
+		{"move shifted register", "lsl r1, r4, #2", []byte{0xA1, 0x00}},
+		{"load address from pc", "add r1, pc, #748", []byte{0xBB, 0xA1}},
+		{"load address from sp", "add r0, sp, #0", []byte{0x00, 0xA8}},
+		{"add offset to sp", "add sp, #0", []byte{0x00, 0xB0}},
+		{"subtract offset from sp", "sub sp, #132", []byte{0xA1, 0xB0}},
+		{"push", "push {r0, r5, r7}", []byte{0xA1, 0xB4}},
+		{"push with lr", "push {r0, r5, r7, lr}", []byte{0xA1, 0xB5}},
+		{"pop with pc", "pop {pc}", []byte{0x00, 0xBD}},
+		{"pop nothing", "pop {}", []byte{0x00, 0xBC}},
+		{"store multiple", "stmia r0!, {r0, r5, r7}", []byte{0xA1, 0xC0}},
+		{"load multiple nothing", "ldmia r7!, {}", []byte{0x00, 0xCF}},
+		{"conditional branch forward", "beq 0x2000004", []byte{0x00, 0xD0}},
+		{"conditional branch back", "bne 0x1FFFF7A", []byte{0xBB, 0xD1}},
+		{"software interrupt", "swi #0xA1", []byte{0xA1, 0xDF}},
+		{"branch", "b 0x2000004", []byte{0x00, 0xE0}},
+		{"undefined halfword", ".hword 0xBB00", []byte{0x00, 0xBB}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Assemble(tt.src, testBase)
+			if err != nil {
+				t.Fatalf("Assemble(%q): %v", tt.src, err)
+			}
+
+			if got := p.Names[:len(tt.bytes)]; !bytes.Equal(got, tt.bytes) {
+				t.Fatalf("Assemble(%q) = % X, want % X", tt.src, got, tt.bytes)
+			}
+
+			lines := Disassemble(p.Names[:len(tt.bytes)], testBase, false)
+			if len(lines) != 1 || lines[0].Text != tt.src {
+				t.Fatalf("Disassemble(% X) = %+v, want %q", tt.bytes, lines, tt.src)
+			}
+		})
+	}
+}
+
+func TestAssembleUntypeable(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{"add/subtract", "mov r0, r1"},
+		{"alu", "and r0, r1"},
+		{"hi register", "bx lr"},
+		{"load with immediate offset", "ldr r0, [r1]"},
+		{"long branch with link", "bl 0x2000004"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Assemble(tt.src, testBase); !errors.Is(err, utils.ErrAsmUntypeable) {
+				t.Fatalf("Assemble(%q) error = %v, want %v", tt.src, err, utils.ErrAsmUntypeable)
+			}
+		})
+	}
+}
+
+// Every halfword the naming screen can type disassembles to text that
+// assembles back to it
+func TestTypeableHalfwordsRoundTrip(t *testing.T) {
+	for v := range 0x10000 {
+		hw := uint16(v)
+		if !Typeable(byte(hw)) || !Typeable(byte(hw>>8)) {
+			continue
+		}
+
+		text, _ := DecodeThumb(hw, 0, testBase)
+		p, err := Assemble(text, testBase)
+		if err != nil {
+			t.Errorf("%04X %q: %v", hw, text, err)
+			continue
+		}
+		if got := binary.LittleEndian.Uint16(p.Names); got != hw {
+			t.Errorf("%04X %q assembled to %04X", hw, text, got)
+		}
+	}
+}
+
+func TestAssembleTerminators(t *testing.T) {
+	src := "add sp, #0\nadd sp, #0\nadd sp, #0\nadd sp, #0\nadd sp, #0\nadd sp, #0\nadd sp, #0"
+	want := []string{
+		"add sp, #0",
+		"add sp, #0",
+		"add sp, #0",
+		"add sp, #0",
+		"add r7, pc, #1020",
+		"add sp, #0",
+		"add sp, #0",
+		"b 0x2000012",
+		".hword 0xFF00",
+		"add sp, #0",
+	}
+
+	p, err := Assemble(src, testBase)
+	if err != nil {
+		t.Fatalf("Assemble: %v", err)
+	}
+
+	lines := Disassemble(p.Names[:len(want)*2], testBase, false)
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(lines), len(want))
+	}
+
+	for i, l := range lines {
+		if l.Text != want[i] {
+			t.Errorf("line %d = %q, want %q", i, l.Text, want[i])
+		}
+		if p.Lines[i].Text != l.Text {
+			t.Errorf("line %d assembled as %q, disassembled as %q", i, p.Lines[i].Text, l.Text)
+		}
+	}
+}
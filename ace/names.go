@@ -0,0 +1,82 @@
+package ace
+
+import (
+	"postal/save"
+)
+
+const (
+	NameSize  = int(save.BoxNameSize)
+	NameCount = save.PCBoxCount
+
+	// Every box name ends in this byte whatever was typed
+	Terminator = 0xFF
+
+	// All 14 names back to back, the way the game lays them out in memory
+	PayloadSize = NameSize * NameCount
+)
+
+// Typeable reports whether the box naming screen has a key for b. Those
+// are space, digits, letters and the symbols on the others page
+func Typeable(b byte) bool {
+	switch {
+	case b == 0x00:
+		return true
+	case b >= 0xA1 && b <= 0xAE:
+		// 0-9 ! ? . -
+		return true
+	case b >= 0xB0 && b <= 0xB6:
+		// … “ ” ‘ ’ ♂ ♀
+		return true
+	case b == 0xB8, b == 0xBA:
+		// , /
+		return true
+	case b >= 0xBB && b <= 0xEE:
+		// A-Z a-z
+		return true
+	}
+	return false
+}
+
+// IsTerminator reports whether off in the payload is the byte closing
+// one of the names
+func IsTerminator(off int) bool {
+	return off%NameSize == NameSize-1
+}
+
+// Line is one decoded instruction, or a filler the assembler put in to
+// get around the terminators
+type Line struct {
+	Offset int
+	Addr   uint32
+	Bytes  []byte
+	Text   string
+
+	// Source line the instruction came from, 0 for fillers and for
+	// disassembled lines
+	Source int
+}
+
+// Box is the 0 based box whose name the instruction starts in
+func (l Line) Box() int {
+	return l.Offset / NameSize
+}
+
+// HasTerminator reports whether any byte of the instruction is the end
+// of a name
+func (l Line) HasTerminator() bool {
+	for i := range l.Bytes {
+		if IsTerminator(l.Offset + i) {
+			return true
+		}
+	}
+	return false
+}
+
+// Disassemble decodes the names as one stream of code, with the first
+// name at base
+func Disassemble(names []byte, base uint32, arm bool) []Line {
+	if arm {
+		return disassembleARM(names, base)
+	}
+	return disassembleThumb(names, base)
+}
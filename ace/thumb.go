@@ -0,0 +1,247 @@
+package ace
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+var (
+	condNames = []string{
+		"eq", "ne", "cs", "cc", "mi", "pl", "vs", "vc",
+		"hi", "ls", "ge", "lt", "gt", "le", "", "nv",
+	}
+
+	thumbShiftOps = []string{"lsl", "lsr", "asr"}
+	thumbImmOps   = []string{"mov", "cmp", "add", "sub"}
+	thumbALUOps   = []string{
+		"and", "eor", "lsl", "lsr", "asr", "adc", "sbc", "ror",
+		"tst", "neg", "cmp", "cmn", "orr", "mul", "bic", "mvn",
+	}
+	thumbHiOps = []string{"add", "cmp", "mov", "bx"}
+)
+
+func regName(r uint32) string {
+	switch r {
+	case 13:
+		return "sp"
+	case 14:
+		return "lr"
+	case 15:
+		return "pc"
+	}
+	return fmt.Sprintf("r%d", r)
+}
+
+// Registers in a list, runs of three or more written as a range
+func regList(mask uint32, extra string) string {
+	var regs []string
+	for i := uint32(0); i < 16; i++ {
+		if mask&(1<<i) == 0 {
+			continue
+		}
+
+		j := i
+		for j+1 < 16 && mask&(1<<(j+1)) != 0 {
+			j++
+		}
+
+		switch {
+		case j-i >= 2:
+			regs = append(regs, regName(i)+"-"+regName(j))
+		case j > i:
+			regs = append(regs, regName(i), regName(j))
+		default:
+			regs = append(regs, regName(i))
+		}
+		i = j
+	}
+
+	if extra != "" {
+		regs = append(regs, extra)
+	}
+	return "{" + strings.Join(regs, ", ") + "}"
+}
+
+func signExtend(v uint32, bits uint) int32 {
+	shift := 32 - bits
+	return int32(v<<shift) >> shift
+}
+
+// DecodeThumb gives the text for one halfword at addr. BL is split over
+// two halfwords, next is the one after and is only used for that
+func DecodeThumb(hw, next uint16, addr uint32) (string, int) {
+	op := uint32(hw)
+	rd := op & 7
+	rs := (op >> 3) & 7
+	rb := rs
+
+	switch {
+	// Move shifted register
+	case op>>13 == 0 && (op>>11)&3 != 3:
+		n := (op >> 6) & 31
+		if n == 0 && (op>>11)&3 != 0 {
+			// LSR and ASR #0 mean #32
+			n = 32
+		}
+		return fmt.Sprintf("%s r%d, r%d, #%d", thumbShiftOps[(op>>11)&3], rd, rs, n), 2
+
+	// Add/subtract
+	case op>>11 == 3:
+		name := "add"
+		if op&(1<<9) != 0 {
+			name = "sub"
+		}
+		rn := (op >> 6) & 7
+		if op&(1<<10) == 0 {
+			return fmt.Sprintf("%s r%d, r%d, r%d", name, rd, rs, rn), 2
+		}
+		if name == "add" && rn == 0 {
+			return fmt.Sprintf("mov r%d, r%d", rd, rs), 2
+		}
+		return fmt.Sprintf("%s r%d, r%d, #%d", name, rd, rs, rn), 2
+
+	// Move/compare/add/subtract immediate
+	case op>>13 == 1:
+		return fmt.Sprintf("%s r%d, #%d", thumbImmOps[(op>>11)&3], (op>>8)&7, op&0xFF), 2
+
+	// ALU operations
+	case op>>10 == 0x10:
+		return fmt.Sprintf("%s r%d, r%d", thumbALUOps[(op>>6)&15], rd, rs), 2
+
+	// Hi register operations and branch exchange
+	case op>>10 == 0x11:
+		o := (op >> 8) & 3
+		hd := rd | (op>>7)&1<<3
+		hs := (op >> 3) & 15
+		if o == 3 {
+			return "bx " + regName(hs), 2
+		}
+		return fmt.Sprintf("%s %s, %s", thumbHiOps[o], regName(hd), regName(hs)), 2
+
+	// PC relative load
+	case op>>11 == 9:
+		target := (addr+4)&^3 + (op&0xFF)*4
+		return fmt.Sprintf("ldr r%d, [pc, #%d] @ 0x%X", (op>>8)&7, (op&0xFF)*4, target), 2
+
+	// Load/store with register offset and sign extended byte/halfword
+	case op>>12 == 5:
+		ro := (op >> 6) & 7
+		names := []string{"str", "strb", "ldr", "ldrb"}
+		if op&(1<<9) != 0 {
+			names = []string{"strh", "ldsb", "ldrh", "ldsh"}
+		}
+		return fmt.Sprintf("%s r%d, [r%d, r%d]", names[(op>>10)&3], rd, rb, ro), 2
+
+	// Load/store with immediate offset
+	case op>>13 == 3:
+		off := (op >> 6) & 31
+		name := "str"
+		if op&(1<<11) != 0 {
+			name = "ldr"
+		}
+		if op&(1<<12) != 0 {
+			name += "b"
+		} else {
+			off *= 4
+		}
+		return fmt.Sprintf("%s r%d, [r%d, #%d]", name, rd, rb, off), 2
+
+	// Load/store halfword
+	case op>>12 == 8:
+		name := "strh"
+		if op&(1<<11) != 0 {
+			name = "ldrh"
+		}
+		return fmt.Sprintf("%s r%d, [r%d, #%d]", name, rd, rb, (op>>6)&31*2), 2
+
+	// SP relative load/store
+	case op>>12 == 9:
+		name := "str"
+		if op&(1<<11) != 0 {
+			name = "ldr"
+		}
+		return fmt.Sprintf("%s r%d, [sp, #%d]", name, (op>>8)&7, (op&0xFF)*4), 2
+
+	// Load address
+	case op>>12 == 10:
+		src := "pc"
+		if op&(1<<11) != 0 {
+			src = "sp"
+		}
+		return fmt.Sprintf("add r%d, %s, #%d", (op>>8)&7, src, (op&0xFF)*4), 2
+
+	// Add offset to stack pointer
+	case op>>8 == 0xB0:
+		off := int(op&0x7F) * 4
+		if op&(1<<7) != 0 {
+			return fmt.Sprintf("sub sp, #%d", off), 2
+		}
+		return fmt.Sprintf("add sp, #%d", off), 2
+
+	// Push/pop registers
+	case op>>12 == 11 && (op>>9)&3 == 2:
+		if op&(1<<11) != 0 {
+			extra := ""
+			if op&(1<<8) != 0 {
+				extra = "pc"
+			}
+			return "pop " + regList(op&0xFF, extra), 2
+		}
+
+		extra := ""
+		if op&(1<<8) != 0 {
+			extra = "lr"
+		}
+		return "push " + regList(op&0xFF, extra), 2
+
+	// Multiple load/store
+	case op>>12 == 12:
+		name := "stmia"
+		if op&(1<<11) != 0 {
+			name = "ldmia"
+		}
+		return fmt.Sprintf("%s r%d!, %s", name, (op>>8)&7, regList(op&0xFF, "")), 2
+
+	// Software interrupt
+	case op>>8 == 0xDF:
+		return fmt.Sprintf("swi #0x%X", op&0xFF), 2
+
+	// Conditional branch
+	case op>>12 == 13 && (op>>8)&15 != 14:
+		target := int64(addr) + 4 + int64(signExtend(op&0xFF, 8))*2
+		return fmt.Sprintf("b%s 0x%X", condNames[(op>>8)&15], uint32(target)), 2
+
+	// Unconditional branch
+	case op>>11 == 0x1C:
+		target := int64(addr) + 4 + int64(signExtend(op&0x7FF, 11))*2
+		return fmt.Sprintf("b 0x%X", uint32(target)), 2
+
+	// Long branch with link, only when both halves are in order
+	case op>>11 == 0x1E && uint32(next)>>11 == 0x1F:
+		off := signExtend((op&0x7FF)<<11|uint32(next)&0x7FF, 22)
+		return fmt.Sprintf("bl 0x%X", uint32(int64(addr)+4+int64(off)*2)), 4
+	}
+
+	return fmt.Sprintf(".hword 0x%04X", hw), 2
+}
+
+func disassembleThumb(data []byte, base uint32) []Line {
+	var out []Line
+
+	for off := 0; off+1 < len(data); {
+		hw := binary.LittleEndian.Uint16(data[off:])
+
+		var next uint16
+		if off+3 < len(data) {
+			next = binary.LittleEndian.Uint16(data[off+2:])
+		}
+
+		addr := base + uint32(off)
+		text, n := DecodeThumb(hw, next, addr)
+		out = append(out, Line{Offset: off, Addr: addr, Bytes: data[off : off+n], Text: text})
+		off += n
+	}
+
+	return out
+}
@@ -0,0 +1,51 @@
+package boxes
+
+import (
+	"fmt"
+	"postal/pokemon"
+	"postal/utils"
+)
+
+// MailResult is a box mon before and after a set of mail words was
+// written over it, After is flagged the way the game would flag it
+type MailResult struct {
+	Slot   int
+	Before pokemon.PStructure
+	After  pokemon.PStructure
+	Check  pokemon.BadEggCheck
+}
+
+// ApplyMail writes the same words over the mons in slots, or over every
+// mon in the box when no slots are given. Zero words keep what each mon
+// already has and empty slots are skipped
+func (b *RWPCBox) ApplyMail(words [4]uint16, slots []int) ([]MailResult, error) {
+	if len(slots) == 0 {
+		for i := range b.Mons {
+			slots = append(slots, i)
+		}
+	}
+
+	out := make([]MailResult, 0, len(slots))
+
+	for _, i := range slots {
+		if i < 0 || i >= BoxMonCount {
+			return nil, utils.ErrOutOfRange
+		}
+
+		p := &b.Mons[i]
+		if p.IsBlank() {
+			continue
+		}
+
+		ek, err := p.SaveWithMail(p.MailIDs(words))
+		if err != nil {
+			return nil, fmt.Errorf("slot %d: %w", i+1, err)
+		}
+
+		after := pokemon.GeneratePokemonFromRawData(ek, false, false)
+		c := after.SimulateDecrypt()
+		out = append(out, MailResult{Slot: i, Before: *p, After: after, Check: c})
+	}
+
+	return out, nil
+}
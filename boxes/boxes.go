@@ -7,6 +7,7 @@ import (
 )
 
 const (
+	BoxCount    int = 14
 	BoxMonCount int = 30
 	BoxMonSize      = 0x50
 	BoxSize         = BoxMonCount * BoxMonSize
@@ -34,9 +35,9 @@ func GeneratePCBoxBufferMash(t save.RawBoxDataTotal) PCBoxBufferMash {
 }
 
 func GetPCBoxNameString(index int, t save.RawBoxDataTotal) string {
-	nameOffset := index * 0x9
-	rn := utils.GetSliceFromRawData(t.BoxNames, nameOffset, 0x9)
-	return utils.WesternSliceToString(rn)
+	size := int(save.BoxNameSize)
+	rn := utils.GetSliceFromRawData(t.BoxNames, index*size, size)
+	return utils.DecodeText(rn, utils.LangEnglish)
 }
 
 func GetPCBoxWallpaper(index int, t save.RawBoxDataTotal) int {
@@ -49,7 +50,7 @@ func (b PCBoxBufferMash) GenerateBoxMonData(index int) PCBoxMonData {
 }
 
 func (b PCBoxBufferMash) GetMonAtBoxSlot(box int, slot int) []byte {
-	if box > 14 || slot >= BoxMonCount {
+	if box < 0 || box >= BoxCount || slot < 0 || slot >= BoxMonCount {
 		return nil
 	}
 
@@ -0,0 +1,230 @@
+package boxes
+
+import (
+	"postal/pokemon"
+	"postal/save"
+	"postal/utils"
+	"slices"
+)
+
+const (
+	SearchSpecies = iota
+	SearchPID
+	SearchOTID
+	SearchItem
+	SearchGlitched
+)
+
+var SearchFieldNames = []string{"species", "pid", "otid", "item", "glitch"}
+
+// PCStorage decodes every box once and keeps the raw buffer and decoded
+// mons in sync as slots are moved around
+type PCStorage struct {
+	Mash    PCBoxBufferMash
+	Boxes   [BoxCount]RWPCBox
+	names   []byte
+	changed [BoxCount][BoxMonCount]bool
+	renamed [BoxCount]bool
+}
+
+type MonLocation struct {
+	Box  int
+	Slot int
+}
+
+func GeneratePCStorage(t save.RawBoxDataTotal) *PCStorage {
+	s := PCStorage{
+		Mash:  GeneratePCBoxBufferMash(t),
+		names: slices.Clone(t.BoxNames),
+	}
+
+	for i := range s.Boxes {
+		s.Boxes[i] = GenerateRWPCBox(i, t, s.Mash.GenerateBoxMonData(i))
+	}
+
+	return &s
+}
+
+func validLocation(l MonLocation) bool {
+	return l.Box >= 0 && l.Box < BoxCount && l.Slot >= 0 && l.Slot < BoxMonCount
+}
+
+func (s *PCStorage) GetMon(l MonLocation) *pokemon.PStructure {
+	if !validLocation(l) {
+		return nil
+	}
+	return &s.Boxes[l.Box].Mons[l.Slot]
+}
+
+// SetMonData overwrites a slot with raw (encrypted) box mon data
+func (s *PCStorage) SetMonData(l MonLocation, data []byte) error {
+	if !validLocation(l) {
+		return utils.ErrOutOfRange
+	}
+
+	if len(data) < BoxMonSize {
+		return utils.ErrPKIncorrectFileSize
+	}
+
+	copy(s.Mash.GetMonAtBoxSlot(l.Box, l.Slot), data[:BoxMonSize])
+	s.refreshSlot(l)
+	return nil
+}
+
+func (s *PCStorage) refreshSlot(l MonLocation) {
+	s.Boxes[l.Box].Mons[l.Slot] = pokemon.GeneratePokemonFromRawData(
+		s.Mash.GetMonAtBoxSlot(l.Box, l.Slot),
+		true,
+		false,
+	)
+	s.changed[l.Box][l.Slot] = true
+}
+
+func (s *PCStorage) getBoxName(box int) []byte {
+	size := int(save.BoxNameSize)
+	return utils.GetSliceFromRawData(s.names, box*size, size)
+}
+
+// EncodeBoxName gives the bytes SetBoxName would write for name
+func EncodeBoxName(name string) ([]byte, error) {
+	return utils.EncodeText(name, int(save.BoxNameSize), utils.LangEnglish)
+}
+
+// SetBoxName encodes name with the same escapes the box names decode
+// with, so names holding code come back byte for byte
+func (s *PCStorage) SetBoxName(box int, name string) error {
+	if box < 0 || box >= BoxCount {
+		return utils.ErrOutOfRange
+	}
+
+	b, err := EncodeBoxName(name)
+	if err != nil {
+		return err
+	}
+
+	copy(s.getBoxName(box), b)
+	s.Boxes[box].Name = utils.DecodeText(b, utils.LangEnglish)
+	s.renamed[box] = true
+	return nil
+}
+
+// BoxNameData is every box name's raw bytes back to back
+func (s *PCStorage) BoxNameData() []byte {
+	return slices.Clone(s.names)
+}
+
+// SetBoxNameData writes a box name byte for byte, terminator included
+func (s *PCStorage) SetBoxNameData(box int, b []byte) error {
+	if box < 0 || box >= BoxCount || uint(len(b)) != save.BoxNameSize {
+		return utils.ErrOutOfRange
+	}
+
+	copy(s.getBoxName(box), b)
+	s.Boxes[box].Name = utils.DecodeText(b, utils.LangEnglish)
+	s.renamed[box] = true
+	return nil
+}
+
+func (s *PCStorage) MoveMon(from, to MonLocation) error {
+	if err := s.CloneMon(from, to); err != nil {
+		return err
+	}
+
+	clear(s.Mash.GetMonAtBoxSlot(from.Box, from.Slot))
+	s.refreshSlot(from)
+	return nil
+}
+
+func (s *PCStorage) CloneMon(from, to MonLocation) error {
+	if !validLocation(from) || !validLocation(to) {
+		return utils.ErrOutOfRange
+	}
+
+	if from == to {
+		return nil
+	}
+
+	copy(s.Mash.GetMonAtBoxSlot(to.Box, to.Slot), s.Mash.GetMonAtBoxSlot(from.Box, from.Slot))
+	s.refreshSlot(to)
+	return nil
+}
+
+func (s *PCStorage) SwapMons(a, b MonLocation) error {
+	if !validLocation(a) || !validLocation(b) {
+		return utils.ErrOutOfRange
+	}
+
+	tmp := make([]byte, BoxMonSize)
+	copy(tmp, s.Mash.GetMonAtBoxSlot(a.Box, a.Slot))
+	copy(s.Mash.GetMonAtBoxSlot(a.Box, a.Slot), s.Mash.GetMonAtBoxSlot(b.Box, b.Slot))
+	copy(s.Mash.GetMonAtBoxSlot(b.Box, b.Slot), tmp)
+
+	s.refreshSlot(a)
+	s.refreshSlot(b)
+	return nil
+}
+
+// Search returns every non empty slot where the field matches v.
+// v is ignored when searching for glitched mons
+func (s *PCStorage) Search(field int, v uint32) []MonLocation {
+	var out []MonLocation
+
+	for i := range s.Boxes {
+		for j := range s.Boxes[i].Mons {
+			p := &s.Boxes[i].Mons[j]
+			if p.IsBlank() {
+				continue
+			}
+
+			var match bool
+			switch field {
+			case SearchSpecies:
+				match = uint32(p.Sub0.Species) == v
+			case SearchPID:
+				match = p.PID == v
+			case SearchOTID:
+				match = p.OTID == v
+			case SearchItem:
+				match = uint32(p.Sub0.HeldItem) == v
+			case SearchGlitched:
+				match = p.IsGlitched()
+			}
+
+			if match {
+				out = append(out, MonLocation{Box: i, Slot: j})
+			}
+		}
+	}
+
+	return out
+}
+
+// WriteToSave copies every slot and box name changed since the storage
+// was generated back into the save block
+func (s *PCStorage) WriteToSave(b *save.SaveBlock) error {
+	for i := range s.changed {
+		for j := range s.changed[i] {
+			if !s.changed[i][j] {
+				continue
+			}
+
+			if err := b.SetBoxMon(i, j, s.Mash.GetMonAtBoxSlot(i, j)); err != nil {
+				return err
+			}
+			s.changed[i][j] = false
+		}
+	}
+
+	for i := range s.renamed {
+		if !s.renamed[i] {
+			continue
+		}
+
+		if err := b.SetBoxName(i, s.getBoxName(i)); err != nil {
+			return err
+		}
+		s.renamed[i] = false
+	}
+
+	return nil
+}
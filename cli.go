@@ -0,0 +1,798 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"postal/ace"
+	"postal/boxes"
+	"postal/game"
+	"postal/pokemon"
+	"postal/save"
+	"postal/utils"
+	"strings"
+)
+
+var errNoSlotSelected = errors.New("save files need -party or -box and -slot to pick a mon")
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string, w io.Writer) error
+}
+
+var commands = []command{
+	{"dump", "dump [-backup] [-box n -slot n | -party n] [-raw] <file>", runDump},
+	{"convert", "convert [-backup] [-box n -slot n | -party n] <in> <out.pk3|.ek3|.json|.yaml>", runConvert},
+	{"mail", "mail [-backup] [-box n -slot n | -party n] [-pidhi w] [-pidlo w] [-sid w] [-tid w] [-o out] <file>", runMail},
+	{"boxes", "boxes [-backup] [-box n] <file.sav>", runBoxes},
+	{"check", "check <file.sav>", runCheck},
+	{"diff", "diff [-backup] [-box n -slot n | -party n] [-backup2] [-box2 n -slot2 n | -party2 n] [-raw] <a> <b>", runDiff},
+	{"disasm", "disasm [-backup] [-arm] [-base addr] <file.sav>", runDisasm},
+	{"asm", "asm [-base addr] [-o out.sav] <code.s> [file.sav]", runAsm},
+	{"rom", "rom [-species n] [-move n] [-item n] [-ability n] <file.gba>", runROM},
+	{"exp", "exp [-species n | -rate n] [-level n | -exp n]", runExp},
+}
+
+func findCommand(name string) *command {
+	for i := range commands {
+		if commands[i].name == name {
+			return &commands[i]
+		}
+	}
+	return nil
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "usage: postal [file]")
+	for _, c := range commands {
+		fmt.Fprintf(w, "       postal %s\n", c.usage)
+	}
+}
+
+// Picks which mon to read out of a .sav, slots and boxes are 1 based
+// to match what the game and the TUI show
+type slotFlags struct {
+	box    int
+	slot   int
+	party  int
+	backup bool
+}
+
+func addSlotFlags(fs *flag.FlagSet) *slotFlags {
+	return addSuffixedSlotFlags(fs, "")
+}
+
+// Commands reading two mons take a second set of flags ending in the suffix
+func addSuffixedSlotFlags(fs *flag.FlagSet, suffix string) *slotFlags {
+	s := slotFlags{}
+	fs.IntVar(&s.box, "box"+suffix, 0, "pc box number (1-14)")
+	fs.IntVar(&s.slot, "slot"+suffix, 0, "pc box slot (1-30)")
+	fs.IntVar(&s.party, "party"+suffix, 0, "party slot (1-6)")
+	fs.BoolVar(&s.backup, "backup"+suffix, false, "read the backup save block instead of the active one")
+	return &s
+}
+
+func newFlagSet(c string, w io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(c, flag.ContinueOnError)
+	fs.SetOutput(w)
+	return fs
+}
+
+// Bad checksums are only warned about so broken saves can still be read
+func loadSaveBlock(p string, backup bool) (save.SaveBlock, error) {
+	raw, err := save.GenerateRawSaveData(p)
+	if err != nil {
+		return save.SaveBlock{}, err
+	}
+
+	var b save.SaveBlock
+	if backup {
+		b, err = raw.GenerateBackupSaveBlock()
+	} else {
+		b, err = raw.GenerateSaveBlock()
+	}
+
+	if errors.Is(err, utils.ErrSaveChecksum) {
+		fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+		return b, nil
+	}
+
+	return b, err
+}
+
+// Reads the mon as decrypted pk3 ordered bytes so nothing gets lost
+// going through PStructure on the way to another format
+func loadMonData(p string, s *slotFlags) ([]byte, error) {
+	switch determineExtension(p) {
+	case pk3:
+		return save.GetRawMonDataFromFile(p)
+
+	case ek3:
+		d, err := save.GetRawMonDataFromFile(p)
+		if err != nil {
+			return nil, err
+		}
+		return pokemon.DecryptMonData(d), nil
+
+	case jsonDoc, yamlDoc:
+		b, err := os.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+
+		var d pokemon.MonDocument
+		if determineExtension(p) == jsonDoc {
+			d, err = pokemon.ParseMonDocumentJSON(b)
+		} else {
+			d, err = pokemon.ParseMonDocumentYAML(b)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		return d.ToPK3()
+
+	case sav:
+		b, err := loadSaveBlock(p, s.backup)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case s.party > 0:
+			t := b.GetRawTeamAndItems()
+			if s.party > t.GetTeamSize() {
+				return nil, utils.ErrOutOfRange
+			}
+			return pokemon.DecryptMonData(t.GetPartyMonData(s.party - 1)), nil
+
+		case s.box > 0 && s.slot > 0:
+			mash := boxes.GeneratePCBoxBufferMash(b.GetRawBoxData())
+			d := mash.GetMonAtBoxSlot(s.box-1, s.slot-1)
+			if d == nil {
+				return nil, utils.ErrOutOfRange
+			}
+
+			// Box mons don't store the party data so fill it in from
+			// the calculated stats
+			pk := pokemon.GeneratePokemonFromRawData(d, true, false)
+			return append(pokemon.DecryptMonData(d), pk.MakeSecondPartRawMon()...), nil
+		}
+
+		return nil, errNoSlotSelected
+	}
+
+	return nil, fmt.Errorf("unsupported file type %q", path.Ext(p))
+}
+
+func loadMon(p string, s *slotFlags) (pokemon.PStructure, error) {
+	d, err := loadMonData(p, s)
+	if err != nil {
+		return pokemon.PStructure{}, err
+	}
+
+	return pokemon.GeneratePokemonFromRawData(d, false, true), nil
+}
+
+func writeMonData(p string, d []byte) error {
+	switch determineExtension(p) {
+	case pk3:
+		return os.WriteFile(p, d, 0644)
+
+	case ek3:
+		return os.WriteFile(p, pokemon.EncryptMonData(d), 0644)
+
+	case jsonDoc, yamlDoc:
+		doc, err := pokemon.NewMonDocument(d)
+		if err != nil {
+			return err
+		}
+
+		var b []byte
+		if determineExtension(p) == jsonDoc {
+			b, err = doc.ToJSON()
+		} else {
+			b, err = doc.ToYAML()
+		}
+
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(p, b, 0644)
+	}
+
+	return fmt.Errorf("can only write .pk3, .ek3, .json or .yaml files, got %q", path.Ext(p))
+}
+
+func printMon(w io.Writer, pk *pokemon.PStructure) {
+	sp, species := pk.GetSpecies()
+	it, item := pk.GetHeldItem()
+	_, nature := pk.GetNature()
+	_, ability := pk.GetAbility()
+	_, status := pk.GetStatusCondition()
+	tid, sid := pk.GetTIDSIDComboPair()
+
+	fmt.Fprintf(w, "Species   %s (0x%X)\n", species, sp)
+	fmt.Fprintf(w, "Nickname  %s\n", pk.GetCleanNickname())
+	fmt.Fprintf(w, "OT        %s\n", pk.GetCleanOTName())
+	fmt.Fprintf(w, "PID       %08X\n", pk.PID)
+	fmt.Fprintf(w, "OTID      %08X (TID %05d SID %05d)\n", pk.OTID, tid, sid)
+	fmt.Fprintf(w, "XKEY      %08X\n", pk.GetEncryptionKey())
+	fmt.Fprintf(w, "Level     %d\n", pk.Level)
+	fmt.Fprintf(w, "Nature    %s\n", nature)
+	fmt.Fprintf(w, "Ability   %s\n", ability)
+	fmt.Fprintf(w, "Item      %s (0x%X)\n", item, it)
+	fmt.Fprintf(w, "Moves     %s\n", strings.Join(pk.GetMoveStrings(), ", "))
+	fmt.Fprintf(w, "Status    %s\n", status)
+	fmt.Fprintf(w, "Glitched  %t\n", pk.IsGlitched())
+}
+
+func printTrainer(w io.Writer, b *save.SaveBlock) {
+	t := b.GetTrainerInfo()
+	party := b.GetRawTeamAndItems()
+
+	fmt.Fprintf(w, "Game      %s\n", t.Game)
+	fmt.Fprintf(w, "Trainer   %s (%s)\n", t.Name, t.GetGender())
+	fmt.Fprintf(w, "TID/SID   %05d / %05d\n", t.TID, t.SID)
+	fmt.Fprintf(w, "Played    %d:%02d:%02d\n", t.Time.Hours, t.Time.Minutes, t.Time.Seconds)
+	fmt.Fprintf(w, "Money     %d\n", b.GetMoney())
+
+	for i := range party.GetTeamSize() {
+		pk := pokemon.GeneratePokemonFromRawData(party.GetPartyMonData(i), false, false)
+		_, species := pk.GetSpecies()
+		fmt.Fprintf(w, "Party %d   %s Lv %d %08X\n", i+1, species, pk.Level, pk.PID)
+	}
+}
+
+func runDump(args []string, w io.Writer) error {
+	fs := newFlagSet("dump", w)
+	s := addSlotFlags(fs)
+	raw := fs.Bool("raw", false, "also print the decrypted pk3 bytes")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 {
+		return errors.New("dump takes exactly one file")
+	}
+
+	p := fs.Arg(0)
+
+	// A save without a slot dumps the trainer instead
+	if determineExtension(p) == sav && s.party == 0 && s.box == 0 {
+		b, err := loadSaveBlock(p, s.backup)
+		if err != nil {
+			return err
+		}
+
+		printTrainer(w, &b)
+		return nil
+	}
+
+	d, err := loadMonData(p, s)
+	if err != nil {
+		return err
+	}
+
+	pk := pokemon.GeneratePokemonFromRawData(d, false, true)
+	printMon(w, &pk)
+
+	if *raw {
+		fmt.Fprint(w, hex.Dump(d))
+	}
+
+	return nil
+}
+
+func runConvert(args []string, w io.Writer) error {
+	fs := newFlagSet("convert", w)
+	s := addSlotFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 2 {
+		return errors.New("convert takes an input and an output file")
+	}
+
+	d, err := loadMonData(fs.Arg(0), s)
+	if err != nil {
+		return err
+	}
+
+	if err := writeMonData(fs.Arg(1), d); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "wrote %s\n", fs.Arg(1))
+	return nil
+}
+
+func runMail(args []string, w io.Writer) error {
+	fs := newFlagSet("mail", w)
+	s := addSlotFlags(fs)
+
+	// Same order the mail editor uses: PID hi, PID lo, SID, TID
+	names := []string{"pidhi", "pidlo", "sid", "tid"}
+	words := make([]*string, len(names))
+	for i, n := range names {
+		words[i] = fs.String(n, "", "word written over the "+n+" half")
+	}
+	out := fs.String("o", "", "write the result to a .pk3, .ek3, .json or .yaml file")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 {
+		return errors.New("mail takes exactly one file")
+	}
+
+	pk, err := loadMon(fs.Arg(0), s)
+	if err != nil {
+		return err
+	}
+
+	h, l := pk.GetPIDSplit()
+	tid, sid := pk.GetTIDSIDComboPair()
+	vl := []uint16{h, l, sid, tid}
+
+	for i := range words {
+		if *words[i] == "" {
+			continue
+		}
+
+		if vl[i], err = pokemon.ParseMailWord(*words[i]); err != nil {
+			return err
+		}
+	}
+
+	r, err := pk.SaveWithMail(
+		pokemon.CombineU16Split(vl[0], vl[1]),
+		pokemon.CombineU16Split(vl[2], vl[3]),
+	)
+	if err != nil {
+		return err
+	}
+
+	res := pokemon.GeneratePokemonFromRawData(r, false, false)
+	c := res.CheckBadEgg()
+	printMon(w, &res)
+	fmt.Fprintf(w, "Bad Egg   %t (stored checksum %04X, game computes %04X)\n",
+		c.WillBeBadEgg(), c.Stored, c.Computed)
+
+	if *out != "" {
+		return writeMonData(*out, pokemon.DecryptMonData(r))
+	}
+
+	return nil
+}
+
+func runBoxes(args []string, w io.Writer) error {
+	fs := newFlagSet("boxes", w)
+	only := fs.Int("box", 0, "only list this box (1-14)")
+	backup := fs.Bool("backup", false, "read the backup save block instead of the active one")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 || determineExtension(fs.Arg(0)) != sav {
+		return errors.New("boxes takes exactly one .sav file")
+	}
+
+	if *only < 0 || *only > boxes.BoxCount {
+		return utils.ErrOutOfRange
+	}
+
+	b, err := loadSaveBlock(fs.Arg(0), *backup)
+	if err != nil {
+		return err
+	}
+
+	pc := boxes.GeneratePCStorage(b.GetRawBoxData())
+
+	fmt.Fprintln(w, "box\tslot\tspecies\tpid\totid\tlevel\titem\tglitched")
+	for i := range pc.Boxes {
+		if *only != 0 && i != *only-1 {
+			continue
+		}
+
+		for j := range pc.Boxes[i].Mons {
+			pk := &pc.Boxes[i].Mons[j]
+			if pk.IsBlank() {
+				continue
+			}
+
+			_, species := pk.GetSpecies()
+			_, item := pk.GetHeldItem()
+			fmt.Fprintf(w, "%d\t%d\t%s\t%08X\t%08X\t%d\t%s\t%t\n",
+				i+1, j+1, species, pk.PID, pk.OTID, pk.Level, item, pk.IsGlitched())
+		}
+	}
+
+	return nil
+}
+
+func okString(ok bool) string {
+	if ok {
+		return "ok"
+	}
+	return "BAD"
+}
+
+// Lists every section footer in both blocks. Fails when the block the
+// game would load has problems so scripts can check the exit code
+func runCheck(args []string, w io.Writer) error {
+	fs := newFlagSet("check", w)
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 || determineExtension(fs.Arg(0)) != sav {
+		return errors.New("check takes exactly one .sav file")
+	}
+
+	raw, err := save.GenerateRawSaveData(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	r, err := raw.CheckIntegrity()
+	if err != nil {
+		return err
+	}
+
+	var failed bool
+	for _, b := range r.Blocks {
+		state := "backup"
+		if b.Active {
+			state = "active"
+			failed = !b.IsValid()
+		}
+
+		fmt.Fprintf(w, "block %s (0x%05X) %s, save index %d: %s\n",
+			b.Name, b.Offset, state, b.SaveIndex, okString(b.IsValid()))
+		fmt.Fprintln(w, "offset\tid\tsignature\tstored\tcomputed\tindex")
+
+		for _, sec := range b.Sections {
+			fmt.Fprintf(w, "%05X\t%d\t%08X %s\t%04X\t%04X %s\t%d\n",
+				sec.Offset, sec.ID,
+				sec.Signature, okString(sec.SignatureValid),
+				sec.StoredChecksum, sec.ComputedChecksum, okString(sec.ChecksumValid()),
+				sec.SaveIndex)
+		}
+
+		if len(b.Missing) > 0 {
+			fmt.Fprintf(w, "missing sections %v\n", b.Missing)
+		}
+		if len(b.Duplicated) > 0 {
+			fmt.Fprintf(w, "duplicated sections %v\n", b.Duplicated)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if failed {
+		return errors.New("active save block failed the integrity check")
+	}
+
+	return nil
+}
+
+// Flags without a 2 pick the mon out of the first file, flags with one
+// out of the second, so two slots of the same save can be compared
+func runDiff(args []string, w io.Writer) error {
+	fs := newFlagSet("diff", w)
+	sa := addSlotFlags(fs)
+	sb := addSuffixedSlotFlags(fs, "2")
+	raw := fs.Bool("raw", false, "also list the changed ek3 bytes")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 2 {
+		return errors.New("diff takes exactly two files")
+	}
+
+	a, err := loadMon(fs.Arg(0), sa)
+	if err != nil {
+		return err
+	}
+
+	b, err := loadMon(fs.Arg(1), sb)
+	if err != nil {
+		return err
+	}
+
+	d := pokemon.DiffMons(&a, &b)
+	if d.IsEmpty() {
+		fmt.Fprintln(w, "no differences")
+		return nil
+	}
+
+	name := func(v, n string) string {
+		if n == "" {
+			return v
+		}
+		return fmt.Sprintf("%s (%s)", v, n)
+	}
+
+	fmt.Fprintln(w, "field\tfrom\tto")
+	for _, c := range d.Fields {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", c.Field, name(c.Old, c.OldName), name(c.New, c.NewName))
+	}
+
+	fmt.Fprintf(w, "%d ek3 bytes changed\n", len(d.Bytes))
+	if *raw {
+		fmt.Fprintln(w, "offset\tfrom\tto")
+		for _, c := range d.Bytes {
+			fmt.Fprintf(w, "%02X\t%02X\t%02X\n", c.Offset, c.Old, c.New)
+		}
+	}
+
+	return nil
+}
+
+// Box 1's name is at 0 unless told otherwise, which keeps pc relative
+// loads right since the real address is word aligned too
+func addBaseFlag(fs *flag.FlagSet) *uint {
+	return fs.Uint("base", 0, "address of the first byte of box 1's name")
+}
+
+func printListing(w io.Writer, lines []ace.Line) {
+	fmt.Fprintln(w, "box\toffset\taddr\tbytes\tcode")
+	for _, l := range lines {
+		fmt.Fprintf(w, "%d\t%02X\t%08X\t%X\t%s\n", l.Box()+1, l.Offset, l.Addr, l.Bytes, l.Text)
+	}
+}
+
+func runDisasm(args []string, w io.Writer) error {
+	fs := newFlagSet("disasm", w)
+	backup := fs.Bool("backup", false, "read the backup save block instead of the active one")
+	arm := fs.Bool("arm", false, "decode as ARM instead of THUMB")
+	base := addBaseFlag(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 || determineExtension(fs.Arg(0)) != sav {
+		return errors.New("disasm takes exactly one .sav file")
+	}
+
+	b, err := loadSaveBlock(fs.Arg(0), *backup)
+	if err != nil {
+		return err
+	}
+
+	names := b.GetRawBoxData().BoxNames
+	printListing(w, ace.Disassemble(names, uint32(*base), *arm))
+	return nil
+}
+
+// Without a save the names are only printed. With one the names the
+// code reaches are written into its active block, to -o or back over
+// the save itself
+func runAsm(args []string, w io.Writer) error {
+	fs := newFlagSet("asm", w)
+	base := addBaseFlag(fs)
+	out := fs.String("o", "", "write the save here instead of over the input")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() < 1 || fs.NArg() > 2 {
+		return errors.New("asm takes a source file and optionally a .sav file")
+	}
+
+	src, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	p, err := ace.Assemble(string(src), uint32(*base))
+	if err != nil {
+		return err
+	}
+
+	printListing(w, p.Lines)
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "box\tbytes\tname")
+	for i := range p.Used() {
+		fmt.Fprintf(w, "%d\t%X\t%s\n", i+1, p.Name(i), utils.DecodeText(p.Name(i), utils.LangEnglish))
+	}
+
+	if fs.NArg() == 1 {
+		return nil
+	}
+
+	if determineExtension(fs.Arg(1)) != sav {
+		return errors.New("asm can only write into a .sav file")
+	}
+
+	raw, err := save.GenerateRawSaveData(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	b, err := raw.GenerateSaveBlock()
+	if errors.Is(err, utils.ErrSaveChecksum) {
+		fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+	} else if err != nil {
+		return err
+	}
+
+	for i := range p.Used() {
+		if err := b.SetBoxName(i, p.Name(i)); err != nil {
+			return err
+		}
+	}
+
+	if err := raw.WriteSaveBlock(&b); err != nil {
+		return err
+	}
+
+	dst := fs.Arg(1)
+	if *out != "" {
+		dst = *out
+	}
+	return raw.WriteToFile(dst)
+}
+
+// Shows where the tables were found and looks up single entries, which
+// can be past the end of a table the same way a glitch mon's are
+func runROM(args []string, w io.Writer) error {
+	fs := newFlagSet("rom", w)
+	species := fs.Int("species", -1, "species index to look up")
+	move := fs.Int("move", -1, "move index to look up")
+	item := fs.Int("item", -1, "item index to look up")
+	ability := fs.Int("ability", -1, "ability index to look up")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 {
+		return errors.New("rom takes exactly one rom file")
+	}
+
+	if *species > 0xFFFF || *move > 0xFFFF || *item > 0xFFFF || *ability > 0xFF {
+		return utils.ErrOutOfRange
+	}
+
+	r, err := game.LoadROM(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "Game      %s (%s rev %d)\n", r.Title, r.Code, r.Version)
+	fmt.Fprintf(w, "Species   names %06X, base stats %06X\n", r.Tables.SpeciesNames, r.Tables.BaseStats)
+	fmt.Fprintf(w, "Moves     names %06X, data %06X\n", r.Tables.MoveNames, r.Tables.Moves)
+	fmt.Fprintf(w, "Items     %06X, %d entries\n", r.Tables.Items, r.ItemCount)
+	fmt.Fprintf(w, "Abilities %06X\n", r.Tables.AbilityNames)
+
+	if r.Tables.Experience < 0 {
+		fmt.Fprintln(w, "Growth    not found, using built in tables")
+	} else {
+		fmt.Fprintf(w, "Growth    %06X\n", r.Tables.Experience)
+	}
+
+	if *species >= 0 {
+		d, ok := r.Species(uint16(*species))
+		if !ok {
+			return fmt.Errorf("species 0x%X is past the end of the rom", *species)
+		}
+
+		ab0, _ := r.AbilityName(uint8(d.Ab0))
+		ab1, _ := r.AbilityName(uint8(d.Ab1))
+		fmt.Fprintf(w, "\nSpecies   %s (0x%X)\n", d.Name, *species)
+		fmt.Fprintf(w, "Base      %v\n", d.BaseStats)
+		fmt.Fprintf(w, "Growth    %d\n", d.ExpRate)
+		fmt.Fprintf(w, "Gender    %d\n", d.GenderRatio)
+		fmt.Fprintf(w, "Abilities %s / %s\n", ab0, ab1)
+	}
+
+	if *move >= 0 {
+		m, ok := r.Move(uint16(*move))
+		if !ok {
+			return fmt.Errorf("move 0x%X is past the end of the rom", *move)
+		}
+		fmt.Fprintf(w, "\nMove      %s (0x%X), %d PP\n", m.Name, *move, m.PP)
+	}
+
+	if *item >= 0 {
+		n, _ := r.ItemName(uint16(*item))
+		fmt.Fprintf(w, "\nItem      %s (0x%X)\n", n, *item)
+	}
+
+	if *ability >= 0 {
+		n, _ := r.AbilityName(uint8(*ability))
+		fmt.Fprintf(w, "\nAbility   %s (0x%X)\n", n, *ability)
+	}
+
+	return nil
+}
+
+// Converts between level and experience on a species' growth rate. Glitch
+// rates can be given straight with -rate and need POSTAL_ROM to resolve
+func runExp(args []string, w io.Writer) error {
+	fs := newFlagSet("exp", w)
+	species := fs.Int("species", -1, "species whose growth rate to use")
+	rate := fs.Int("rate", -1, "growth rate to use instead of a species'")
+	level := fs.Int("level", -1, "level to get the least experience for")
+	exp := fs.Int64("exp", -1, "experience to get the level for")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 0 {
+		return errors.New("exp doesn't take any files")
+	}
+
+	if (*species < 0) == (*rate < 0) {
+		return errors.New("exp needs exactly one of -species or -rate")
+	}
+
+	if (*level < 0) == (*exp < 0) {
+		return errors.New("exp needs exactly one of -level or -exp")
+	}
+
+	if *species > 0xFFFF || *rate > 0xFF || *level > pokemon.MaxLevel || *exp > 0xFFFFFFFF {
+		return utils.ErrOutOfRange
+	}
+
+	r := uint(*rate)
+	if *species >= 0 {
+		r = pokemon.SpeciesGrowthRate(uint16(*species))
+
+		name, ok := game.SpeciesName(uint16(*species))
+		if !ok {
+			name = "Glitched!"
+		}
+		fmt.Fprintf(w, "Species   %s (0x%X)\n", name, *species)
+	}
+
+	name, ok := game.GrowthRates[r]
+	if !ok {
+		name = "Glitched"
+	}
+	fmt.Fprintf(w, "Growth    %s (%d)\n", name, r)
+
+	xp := uint32(*exp)
+	if *level >= 0 {
+		e, err := pokemon.ExperienceForLevel(r, uint8(*level))
+		if err != nil {
+			return err
+		}
+		xp = e
+	}
+
+	// Glitch tables aren't sorted, so the least experience for a level
+	// can still land on another one
+	lv, err := pokemon.LevelForExperience(r, xp)
+	if err != nil {
+		return err
+	}
+
+	next, err := pokemon.ExperienceToNextLevel(r, xp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "EXP       %d\n", xp)
+	fmt.Fprintf(w, "Level     %d\n", lv)
+	fmt.Fprintf(w, "To next   %d\n", next)
+	return nil
+}
@@ -3,7 +3,7 @@ package game
 type SpeciesData struct {
 	Dex         int
 	Name        string
-	BaseStats   []uint
+	BaseStats   []uint // HP, Atk, Def, SpA, SpD, Spe
 	GenderRatio int
 	ExpRate     uint
 	Ab0         int
@@ -65,6 +65,17 @@ var Natures = map[uint8]string{
 	0x18: "Quirky",
 }
 
+// Growth rates as species data numbers them. Anything higher only exists
+// on glitch species
+var GrowthRates = map[uint]string{
+	0: "Medium Fast",
+	1: "Erratic",
+	2: "Fluctuating",
+	3: "Medium Slow",
+	4: "Fast",
+	5: "Slow",
+}
+
 var NatureStatModifier = map[uint8][]float32{
 	0x00: {1.0, 1.0, 1.0, 1.0, 1.0},
 	0x01: {1.1, 0.9, 1.0, 1.0, 1.0},
@@ -911,417 +922,417 @@ var MoveDataList = []MoveEntry{
 
 var SpeciesDataList = []SpeciesData{
 	{0, "None", []uint{0, 0, 0, 0, 0, 0}, 0, 0, 0, 0, 0, 0},
-	{1, "Bulbasaur", []uint{45, 49, 45, 65, 65, 65}, 31, 3, 65, 65, 11, 3},
-	{2, "Ivysaur", []uint{60, 63, 60, 80, 80, 80}, 31, 3, 65, 65, 11, 3},
-	{3, "Venusaur", []uint{80, 83, 80, 100, 100, 100}, 31, 3, 65, 65, 11, 3},
-	{4, "Charmander", []uint{39, 43, 65, 60, 60, 50}, 31, 3, 66, 66, 9, 9},
-	{5, "Charmeleon", []uint{58, 58, 80, 80, 80, 65}, 31, 3, 66, 66, 9, 9},
-	{6, "Charizard", []uint{78, 78, 100, 109, 109, 85}, 31, 3, 66, 66, 9, 2},
-	{7, "Squirtle", []uint{44, 65, 43, 50, 50, 64}, 31, 3, 67, 67, 10, 10},
-	{8, "Wartortle", []uint{59, 80, 58, 65, 65, 80}, 31, 3, 67, 67, 10, 10},
-	{9, "Blastoise", []uint{79, 100, 78, 85, 85, 105}, 31, 3, 67, 67, 10, 10},
-	{10, "Caterpie", []uint{45, 35, 45, 20, 20, 20}, 127, 0, 19, 19, 6, 6},
-	{11, "Metapod", []uint{50, 55, 30, 25, 25, 25}, 127, 0, 61, 61, 6, 6},
-	{12, "Butterfree", []uint{60, 50, 70, 80, 80, 80}, 127, 0, 14, 14, 6, 2},
-	{13, "Weedle", []uint{40, 30, 50, 20, 20, 20}, 127, 0, 19, 19, 6, 3},
-	{14, "Kakuna", []uint{45, 50, 35, 25, 25, 25}, 127, 0, 61, 61, 6, 3},
-	{15, "Beedrill", []uint{65, 40, 75, 45, 45, 80}, 127, 0, 68, 68, 6, 3},
-	{16, "Pidgey", []uint{40, 40, 56, 35, 35, 35}, 127, 3, 51, 51, 0, 2},
-	{17, "Pidgeotto", []uint{63, 55, 71, 50, 50, 50}, 127, 3, 51, 51, 0, 2},
-	{18, "Pidgeot", []uint{83, 75, 91, 70, 70, 70}, 127, 3, 51, 51, 0, 2},
-	{19, "Rattata", []uint{30, 35, 72, 25, 25, 35}, 127, 0, 50, 62, 0, 0},
-	{20, "Raticate", []uint{55, 60, 97, 50, 50, 70}, 127, 0, 50, 62, 0, 0},
-	{21, "Spearow", []uint{40, 30, 70, 31, 31, 31}, 127, 0, 51, 51, 0, 2},
-	{22, "Fearow", []uint{65, 65, 100, 61, 61, 61}, 127, 0, 51, 51, 0, 2},
-	{23, "Ekans", []uint{35, 44, 55, 40, 40, 54}, 127, 0, 22, 61, 3, 3},
-	{24, "Arbok", []uint{60, 69, 80, 65, 65, 79}, 127, 0, 22, 61, 3, 3},
-	{25, "Pikachu", []uint{35, 30, 90, 50, 50, 40}, 127, 0, 9, 9, 12, 12},
-	{26, "Raichu", []uint{60, 55, 100, 90, 90, 80}, 127, 0, 9, 9, 12, 12},
-	{27, "Sandshrew", []uint{50, 85, 40, 20, 20, 30}, 127, 0, 8, 8, 4, 4},
-	{28, "Sandslash", []uint{75, 110, 65, 45, 45, 55}, 127, 0, 8, 8, 4, 4},
-	{29, "Nidoran-f", []uint{55, 52, 41, 40, 40, 40}, 254, 3, 38, 38, 3, 3},
-	{30, "Nidorina", []uint{70, 67, 56, 55, 55, 55}, 254, 3, 38, 38, 3, 3},
-	{31, "Nidoqueen", []uint{90, 87, 76, 75, 75, 85}, 254, 3, 38, 38, 3, 4},
-	{32, "Nidoran-m", []uint{46, 40, 50, 40, 40, 40}, 0, 3, 38, 38, 3, 3},
-	{33, "Nidorino", []uint{61, 57, 65, 55, 55, 55}, 0, 3, 38, 38, 3, 3},
-	{34, "Nidoking", []uint{81, 77, 85, 85, 85, 75}, 0, 3, 38, 38, 3, 4},
-	{35, "Clefairy", []uint{70, 48, 35, 60, 60, 65}, 191, 4, 56, 56, 0, 0},
-	{36, "Clefable", []uint{95, 73, 60, 85, 85, 90}, 191, 4, 56, 56, 0, 0},
-	{37, "Vulpix", []uint{38, 40, 65, 50, 50, 65}, 191, 0, 18, 18, 9, 9},
-	{38, "Ninetales", []uint{73, 75, 100, 81, 81, 100}, 191, 0, 18, 18, 9, 9},
-	{39, "Jigglypuff", []uint{115, 20, 20, 45, 45, 25}, 191, 4, 56, 56, 0, 0},
-	{40, "Wigglytuff", []uint{140, 45, 45, 75, 75, 50}, 191, 4, 56, 56, 0, 0},
-	{41, "Zubat", []uint{40, 35, 55, 30, 30, 40}, 127, 0, 39, 39, 3, 2},
-	{42, "Golbat", []uint{75, 70, 90, 65, 65, 75}, 127, 0, 39, 39, 3, 2},
-	{43, "Oddish", []uint{45, 55, 30, 75, 75, 65}, 127, 3, 34, 34, 11, 3},
-	{44, "Gloom", []uint{60, 70, 40, 85, 85, 75}, 127, 3, 34, 34, 11, 3},
-	{45, "Vileplume", []uint{75, 85, 50, 100, 100, 90}, 127, 3, 34, 34, 11, 3},
-	{46, "Paras", []uint{35, 55, 25, 45, 45, 55}, 127, 0, 27, 27, 6, 11},
-	{47, "Parasect", []uint{60, 80, 30, 60, 60, 80}, 127, 0, 27, 27, 6, 11},
-	{48, "Venonat", []uint{60, 50, 45, 40, 40, 55}, 127, 0, 14, 14, 6, 3},
-	{49, "Venomoth", []uint{70, 60, 90, 90, 90, 75}, 127, 0, 19, 19, 6, 3},
-	{50, "Diglett", []uint{10, 25, 95, 35, 35, 45}, 127, 0, 8, 71, 4, 4},
-	{51, "Dugtrio", []uint{35, 50, 120, 50, 50, 70}, 127, 0, 8, 71, 4, 4},
-	{52, "Meowth", []uint{40, 35, 90, 40, 40, 40}, 127, 0, 53, 53, 0, 0},
-	{53, "Persian", []uint{65, 60, 115, 65, 65, 65}, 127, 0, 7, 7, 0, 0},
-	{54, "Psyduck", []uint{50, 48, 55, 65, 65, 50}, 127, 0, 6, 13, 10, 10},
-	{55, "Golduck", []uint{80, 78, 85, 95, 95, 80}, 127, 0, 6, 13, 10, 10},
-	{56, "Mankey", []uint{40, 35, 70, 35, 35, 45}, 127, 0, 72, 72, 1, 1},
-	{57, "Primeape", []uint{65, 60, 95, 60, 60, 70}, 127, 0, 72, 72, 1, 1},
-	{58, "Growlithe", []uint{55, 45, 60, 70, 70, 50}, 63, 5, 22, 18, 9, 9},
-	{59, "Arcanine", []uint{90, 80, 95, 100, 100, 80}, 63, 5, 22, 18, 9, 9},
-	{60, "Poliwag", []uint{40, 40, 90, 40, 40, 40}, 127, 3, 11, 6, 10, 10},
-	{61, "Poliwhirl", []uint{65, 65, 90, 50, 50, 50}, 127, 3, 11, 6, 10, 10},
-	{62, "Poliwrath", []uint{90, 95, 70, 70, 70, 90}, 127, 3, 11, 6, 10, 1},
-	{63, "Abra", []uint{25, 15, 90, 105, 105, 55}, 63, 3, 28, 39, 13, 13},
-	{64, "Kadabra", []uint{40, 30, 105, 120, 120, 70}, 63, 3, 28, 39, 13, 13},
-	{65, "Alakazam", []uint{55, 45, 120, 135, 135, 85}, 63, 3, 28, 39, 13, 13},
-	{66, "Machop", []uint{70, 50, 35, 35, 35, 35}, 63, 3, 62, 62, 1, 1},
-	{67, "Machoke", []uint{80, 70, 45, 50, 50, 60}, 63, 3, 62, 62, 1, 1},
-	{68, "Machamp", []uint{90, 80, 55, 65, 65, 85}, 63, 3, 62, 62, 1, 1},
-	{69, "Bellsprout", []uint{50, 35, 40, 70, 70, 30}, 127, 3, 34, 34, 11, 3},
-	{70, "Weepinbell", []uint{65, 50, 55, 85, 85, 45}, 127, 3, 34, 34, 11, 3},
-	{71, "Victreebel", []uint{80, 65, 70, 100, 100, 60}, 127, 3, 34, 34, 11, 3},
-	{72, "Tentacool", []uint{40, 35, 70, 50, 50, 100}, 127, 5, 29, 64, 10, 3},
-	{73, "Tentacruel", []uint{80, 65, 100, 80, 80, 120}, 127, 5, 29, 64, 10, 3},
-	{74, "Geodude", []uint{40, 100, 20, 30, 30, 30}, 127, 3, 69, 5, 5, 4},
-	{75, "Graveler", []uint{55, 115, 35, 45, 45, 45}, 127, 3, 69, 5, 5, 4},
-	{76, "Golem", []uint{80, 130, 45, 55, 55, 65}, 127, 3, 69, 5, 5, 4},
-	{77, "Ponyta", []uint{50, 55, 90, 65, 65, 65}, 127, 0, 50, 18, 9, 9},
-	{78, "Rapidash", []uint{65, 70, 105, 80, 80, 80}, 127, 0, 50, 18, 9, 9},
-	{79, "Slowpoke", []uint{90, 65, 15, 40, 40, 40}, 127, 0, 12, 20, 10, 13},
-	{80, "Slowbro", []uint{95, 110, 30, 100, 100, 80}, 127, 0, 12, 20, 10, 13},
-	{81, "Magnemite", []uint{25, 70, 45, 95, 95, 55}, 255, 0, 42, 5, 12, 8},
-	{82, "Magneton", []uint{50, 95, 70, 120, 120, 70}, 255, 0, 42, 5, 12, 8},
-	{83, "Farfetch'd", []uint{52, 55, 60, 58, 58, 62}, 127, 0, 51, 39, 0, 2},
-	{84, "Doduo", []uint{35, 45, 75, 35, 35, 35}, 127, 0, 50, 48, 0, 2},
-	{85, "Dodrio", []uint{60, 70, 100, 60, 60, 60}, 127, 0, 50, 48, 0, 2},
-	{86, "Seel", []uint{65, 55, 45, 45, 45, 70}, 127, 0, 47, 47, 10, 10},
-	{87, "Dewgong", []uint{90, 80, 70, 70, 70, 95}, 127, 0, 47, 47, 10, 14},
-	{88, "Grimer", []uint{80, 50, 25, 40, 40, 50}, 127, 0, 1, 60, 3, 3},
-	{89, "Muk", []uint{105, 75, 50, 65, 65, 100}, 127, 0, 1, 60, 3, 3},
-	{90, "Shellder", []uint{30, 100, 40, 45, 45, 25}, 127, 5, 75, 75, 10, 10},
-	{91, "Cloyster", []uint{50, 180, 70, 85, 85, 45}, 127, 5, 75, 75, 10, 14},
-	{92, "Gastly", []uint{30, 30, 80, 100, 100, 35}, 127, 3, 26, 26, 7, 3},
-	{93, "Haunter", []uint{45, 45, 95, 115, 115, 55}, 127, 3, 26, 26, 7, 3},
-	{94, "Gengar", []uint{60, 60, 110, 130, 130, 75}, 127, 3, 26, 26, 7, 3},
-	{95, "Onix", []uint{35, 160, 70, 30, 30, 45}, 127, 0, 69, 5, 5, 4},
-	{96, "Drowzee", []uint{60, 45, 42, 43, 43, 90}, 127, 0, 15, 15, 13, 13},
-	{97, "Hypno", []uint{85, 70, 67, 73, 73, 115}, 127, 0, 15, 15, 13, 13},
-	{98, "Krabby", []uint{30, 90, 50, 25, 25, 25}, 127, 0, 52, 75, 10, 10},
-	{99, "Kingler", []uint{55, 115, 75, 50, 50, 50}, 127, 0, 52, 75, 10, 10},
-	{100, "Voltorb", []uint{40, 50, 100, 55, 55, 55}, 255, 0, 43, 9, 12, 12},
-	{101, "Electrode", []uint{60, 70, 140, 80, 80, 80}, 255, 0, 43, 9, 12, 12},
-	{102, "Exeggcute", []uint{60, 80, 40, 60, 60, 45}, 127, 5, 34, 34, 11, 13},
-	{103, "Exeggutor", []uint{95, 85, 55, 125, 125, 65}, 127, 5, 34, 34, 11, 13},
-	{104, "Cubone", []uint{50, 95, 35, 40, 40, 50}, 127, 0, 69, 31, 4, 4},
-	{105, "Marowak", []uint{60, 110, 45, 50, 50, 80}, 127, 0, 69, 31, 4, 4},
-	{106, "Hitmonlee", []uint{50, 53, 87, 35, 35, 110}, 0, 0, 7, 7, 1, 1},
-	{107, "Hitmonchan", []uint{50, 79, 76, 35, 35, 110}, 0, 0, 51, 51, 1, 1},
-	{108, "Lickitung", []uint{90, 75, 30, 60, 60, 75}, 127, 0, 20, 12, 0, 0},
-	{109, "Koffing", []uint{40, 95, 35, 60, 60, 45}, 127, 0, 26, 26, 3, 3},
-	{110, "Weezing", []uint{65, 120, 60, 85, 85, 70}, 127, 0, 26, 26, 3, 3},
-	{111, "Rhyhorn", []uint{80, 95, 25, 30, 30, 30}, 127, 5, 31, 69, 4, 5},
-	{112, "Rhydon", []uint{105, 120, 40, 45, 45, 45}, 127, 5, 31, 69, 4, 5},
-	{113, "Chansey", []uint{250, 5, 50, 35, 35, 105}, 254, 4, 30, 32, 0, 0},
-	{114, "Tangela", []uint{65, 115, 60, 100, 100, 40}, 127, 0, 34, 34, 11, 11},
-	{115, "Kangaskhan", []uint{105, 80, 90, 40, 40, 80}, 254, 0, 48, 48, 0, 0},
-	{116, "Horsea", []uint{30, 70, 60, 70, 70, 25}, 127, 0, 33, 33, 10, 10},
-	{117, "Seadra", []uint{55, 95, 85, 95, 95, 45}, 127, 0, 38, 38, 10, 10},
-	{118, "Goldeen", []uint{45, 60, 63, 35, 35, 50}, 127, 0, 33, 41, 10, 10},
-	{119, "Seaking", []uint{80, 65, 68, 65, 65, 80}, 127, 0, 33, 41, 10, 10},
-	{120, "Staryu", []uint{30, 55, 85, 70, 70, 55}, 255, 5, 35, 30, 10, 10},
-	{121, "Starmie", []uint{60, 85, 115, 100, 100, 85}, 255, 5, 35, 30, 10, 13},
-	{122, "Mr. Mime", []uint{40, 65, 90, 100, 100, 120}, 127, 0, 43, 43, 13, 13},
-	{123, "Scyther", []uint{70, 80, 105, 55, 55, 80}, 127, 0, 68, 68, 6, 2},
-	{124, "Jynx", []uint{65, 35, 95, 115, 115, 95}, 254, 0, 12, 12, 14, 13},
-	{125, "Electabuzz", []uint{65, 57, 105, 95, 95, 85}, 63, 0, 9, 9, 12, 12},
-	{126, "Magmar", []uint{65, 57, 93, 100, 100, 85}, 63, 0, 49, 49, 9, 9},
-	{127, "Pinsir", []uint{65, 100, 85, 55, 55, 70}, 127, 5, 52, 52, 6, 6},
-	{128, "Tauros", []uint{75, 95, 110, 40, 40, 70}, 0, 5, 22, 22, 0, 0},
-	{129, "Magikarp", []uint{20, 55, 80, 15, 15, 20}, 127, 5, 33, 33, 10, 10},
-	{130, "Gyarados", []uint{95, 79, 81, 60, 60, 100}, 127, 5, 22, 22, 10, 2},
-	{131, "Lapras", []uint{130, 80, 60, 85, 85, 95}, 127, 5, 11, 75, 10, 14},
+	{1, "Bulbasaur", []uint{45, 49, 49, 65, 65, 45}, 31, 3, 65, 65, 11, 3},
+	{2, "Ivysaur", []uint{60, 62, 63, 80, 80, 60}, 31, 3, 65, 65, 11, 3},
+	{3, "Venusaur", []uint{80, 82, 83, 100, 100, 80}, 31, 3, 65, 65, 11, 3},
+	{4, "Charmander", []uint{39, 52, 43, 60, 50, 65}, 31, 3, 66, 66, 9, 9},
+	{5, "Charmeleon", []uint{58, 64, 58, 80, 65, 80}, 31, 3, 66, 66, 9, 9},
+	{6, "Charizard", []uint{78, 84, 78, 109, 85, 100}, 31, 3, 66, 66, 9, 2},
+	{7, "Squirtle", []uint{44, 48, 65, 50, 64, 43}, 31, 3, 67, 67, 10, 10},
+	{8, "Wartortle", []uint{59, 63, 80, 65, 80, 58}, 31, 3, 67, 67, 10, 10},
+	{9, "Blastoise", []uint{79, 83, 100, 85, 105, 78}, 31, 3, 67, 67, 10, 10},
+	{10, "Caterpie", []uint{45, 30, 35, 20, 20, 45}, 127, 0, 19, 19, 6, 6},
+	{11, "Metapod", []uint{50, 20, 55, 25, 25, 30}, 127, 0, 61, 61, 6, 6},
+	{12, "Butterfree", []uint{60, 45, 50, 80, 80, 70}, 127, 0, 14, 14, 6, 2},
+	{13, "Weedle", []uint{40, 35, 30, 20, 20, 50}, 127, 0, 19, 19, 6, 3},
+	{14, "Kakuna", []uint{45, 25, 50, 25, 25, 35}, 127, 0, 61, 61, 6, 3},
+	{15, "Beedrill", []uint{65, 80, 40, 45, 80, 75}, 127, 0, 68, 68, 6, 3},
+	{16, "Pidgey", []uint{40, 45, 40, 35, 35, 56}, 127, 3, 51, 51, 0, 2},
+	{17, "Pidgeotto", []uint{63, 60, 55, 50, 50, 71}, 127, 3, 51, 51, 0, 2},
+	{18, "Pidgeot", []uint{83, 80, 75, 70, 70, 91}, 127, 3, 51, 51, 0, 2},
+	{19, "Rattata", []uint{30, 56, 35, 25, 35, 72}, 127, 0, 50, 62, 0, 0},
+	{20, "Raticate", []uint{55, 81, 60, 50, 70, 97}, 127, 0, 50, 62, 0, 0},
+	{21, "Spearow", []uint{40, 60, 30, 31, 31, 70}, 127, 0, 51, 51, 0, 2},
+	{22, "Fearow", []uint{65, 90, 65, 61, 61, 100}, 127, 0, 51, 51, 0, 2},
+	{23, "Ekans", []uint{35, 60, 44, 40, 54, 55}, 127, 0, 22, 61, 3, 3},
+	{24, "Arbok", []uint{60, 85, 69, 65, 79, 80}, 127, 0, 22, 61, 3, 3},
+	{25, "Pikachu", []uint{35, 55, 30, 50, 40, 90}, 127, 0, 9, 9, 12, 12},
+	{26, "Raichu", []uint{60, 90, 55, 90, 80, 100}, 127, 0, 9, 9, 12, 12},
+	{27, "Sandshrew", []uint{50, 75, 85, 20, 30, 40}, 127, 0, 8, 8, 4, 4},
+	{28, "Sandslash", []uint{75, 100, 110, 45, 55, 65}, 127, 0, 8, 8, 4, 4},
+	{29, "Nidoran-f", []uint{55, 47, 52, 40, 40, 41}, 254, 3, 38, 38, 3, 3},
+	{30, "Nidorina", []uint{70, 62, 67, 55, 55, 56}, 254, 3, 38, 38, 3, 3},
+	{31, "Nidoqueen", []uint{90, 82, 87, 75, 85, 76}, 254, 3, 38, 38, 3, 4},
+	{32, "Nidoran-m", []uint{46, 57, 40, 40, 40, 50}, 0, 3, 38, 38, 3, 3},
+	{33, "Nidorino", []uint{61, 72, 57, 55, 55, 65}, 0, 3, 38, 38, 3, 3},
+	{34, "Nidoking", []uint{81, 92, 77, 85, 75, 85}, 0, 3, 38, 38, 3, 4},
+	{35, "Clefairy", []uint{70, 45, 48, 60, 65, 35}, 191, 4, 56, 56, 0, 0},
+	{36, "Clefable", []uint{95, 70, 73, 85, 90, 60}, 191, 4, 56, 56, 0, 0},
+	{37, "Vulpix", []uint{38, 41, 40, 50, 65, 65}, 191, 0, 18, 18, 9, 9},
+	{38, "Ninetales", []uint{73, 76, 75, 81, 100, 100}, 191, 0, 18, 18, 9, 9},
+	{39, "Jigglypuff", []uint{115, 45, 20, 45, 25, 20}, 191, 4, 56, 56, 0, 0},
+	{40, "Wigglytuff", []uint{140, 70, 45, 75, 50, 45}, 191, 4, 56, 56, 0, 0},
+	{41, "Zubat", []uint{40, 45, 35, 30, 40, 55}, 127, 0, 39, 39, 3, 2},
+	{42, "Golbat", []uint{75, 80, 70, 65, 75, 90}, 127, 0, 39, 39, 3, 2},
+	{43, "Oddish", []uint{45, 50, 55, 75, 65, 30}, 127, 3, 34, 34, 11, 3},
+	{44, "Gloom", []uint{60, 65, 70, 85, 75, 40}, 127, 3, 34, 34, 11, 3},
+	{45, "Vileplume", []uint{75, 80, 85, 100, 90, 50}, 127, 3, 34, 34, 11, 3},
+	{46, "Paras", []uint{35, 70, 55, 45, 55, 25}, 127, 0, 27, 27, 6, 11},
+	{47, "Parasect", []uint{60, 95, 80, 60, 80, 30}, 127, 0, 27, 27, 6, 11},
+	{48, "Venonat", []uint{60, 55, 50, 40, 55, 45}, 127, 0, 14, 14, 6, 3},
+	{49, "Venomoth", []uint{70, 65, 60, 90, 75, 90}, 127, 0, 19, 19, 6, 3},
+	{50, "Diglett", []uint{10, 55, 25, 35, 45, 95}, 127, 0, 8, 71, 4, 4},
+	{51, "Dugtrio", []uint{35, 80, 50, 50, 70, 120}, 127, 0, 8, 71, 4, 4},
+	{52, "Meowth", []uint{40, 45, 35, 40, 40, 90}, 127, 0, 53, 53, 0, 0},
+	{53, "Persian", []uint{65, 70, 60, 65, 65, 115}, 127, 0, 7, 7, 0, 0},
+	{54, "Psyduck", []uint{50, 52, 48, 65, 50, 55}, 127, 0, 6, 13, 10, 10},
+	{55, "Golduck", []uint{80, 82, 78, 95, 80, 85}, 127, 0, 6, 13, 10, 10},
+	{56, "Mankey", []uint{40, 80, 35, 35, 45, 70}, 127, 0, 72, 72, 1, 1},
+	{57, "Primeape", []uint{65, 105, 60, 60, 70, 95}, 127, 0, 72, 72, 1, 1},
+	{58, "Growlithe", []uint{55, 70, 45, 70, 50, 60}, 63, 5, 22, 18, 9, 9},
+	{59, "Arcanine", []uint{90, 110, 80, 100, 80, 95}, 63, 5, 22, 18, 9, 9},
+	{60, "Poliwag", []uint{40, 50, 40, 40, 40, 90}, 127, 3, 11, 6, 10, 10},
+	{61, "Poliwhirl", []uint{65, 65, 65, 50, 50, 90}, 127, 3, 11, 6, 10, 10},
+	{62, "Poliwrath", []uint{90, 85, 95, 70, 90, 70}, 127, 3, 11, 6, 10, 1},
+	{63, "Abra", []uint{25, 20, 15, 105, 55, 90}, 63, 3, 28, 39, 13, 13},
+	{64, "Kadabra", []uint{40, 35, 30, 120, 70, 105}, 63, 3, 28, 39, 13, 13},
+	{65, "Alakazam", []uint{55, 50, 45, 135, 85, 120}, 63, 3, 28, 39, 13, 13},
+	{66, "Machop", []uint{70, 80, 50, 35, 35, 35}, 63, 3, 62, 62, 1, 1},
+	{67, "Machoke", []uint{80, 100, 70, 50, 60, 45}, 63, 3, 62, 62, 1, 1},
+	{68, "Machamp", []uint{90, 130, 80, 65, 85, 55}, 63, 3, 62, 62, 1, 1},
+	{69, "Bellsprout", []uint{50, 75, 35, 70, 30, 40}, 127, 3, 34, 34, 11, 3},
+	{70, "Weepinbell", []uint{65, 90, 50, 85, 45, 55}, 127, 3, 34, 34, 11, 3},
+	{71, "Victreebel", []uint{80, 105, 65, 100, 60, 70}, 127, 3, 34, 34, 11, 3},
+	{72, "Tentacool", []uint{40, 40, 35, 50, 100, 70}, 127, 5, 29, 64, 10, 3},
+	{73, "Tentacruel", []uint{80, 70, 65, 80, 120, 100}, 127, 5, 29, 64, 10, 3},
+	{74, "Geodude", []uint{40, 80, 100, 30, 30, 20}, 127, 3, 69, 5, 5, 4},
+	{75, "Graveler", []uint{55, 95, 115, 45, 45, 35}, 127, 3, 69, 5, 5, 4},
+	{76, "Golem", []uint{80, 110, 130, 55, 65, 45}, 127, 3, 69, 5, 5, 4},
+	{77, "Ponyta", []uint{50, 85, 55, 65, 65, 90}, 127, 0, 50, 18, 9, 9},
+	{78, "Rapidash", []uint{65, 100, 70, 80, 80, 105}, 127, 0, 50, 18, 9, 9},
+	{79, "Slowpoke", []uint{90, 65, 65, 40, 40, 15}, 127, 0, 12, 20, 10, 13},
+	{80, "Slowbro", []uint{95, 75, 110, 100, 80, 30}, 127, 0, 12, 20, 10, 13},
+	{81, "Magnemite", []uint{25, 35, 70, 95, 55, 45}, 255, 0, 42, 5, 12, 8},
+	{82, "Magneton", []uint{50, 60, 95, 120, 70, 70}, 255, 0, 42, 5, 12, 8},
+	{83, "Farfetch'd", []uint{52, 65, 55, 58, 62, 60}, 127, 0, 51, 39, 0, 2},
+	{84, "Doduo", []uint{35, 85, 45, 35, 35, 75}, 127, 0, 50, 48, 0, 2},
+	{85, "Dodrio", []uint{60, 110, 70, 60, 60, 100}, 127, 0, 50, 48, 0, 2},
+	{86, "Seel", []uint{65, 45, 55, 45, 70, 45}, 127, 0, 47, 47, 10, 10},
+	{87, "Dewgong", []uint{90, 70, 80, 70, 95, 70}, 127, 0, 47, 47, 10, 14},
+	{88, "Grimer", []uint{80, 80, 50, 40, 50, 25}, 127, 0, 1, 60, 3, 3},
+	{89, "Muk", []uint{105, 105, 75, 65, 100, 50}, 127, 0, 1, 60, 3, 3},
+	{90, "Shellder", []uint{30, 65, 100, 45, 25, 40}, 127, 5, 75, 75, 10, 10},
+	{91, "Cloyster", []uint{50, 95, 180, 85, 45, 70}, 127, 5, 75, 75, 10, 14},
+	{92, "Gastly", []uint{30, 35, 30, 100, 35, 80}, 127, 3, 26, 26, 7, 3},
+	{93, "Haunter", []uint{45, 50, 45, 115, 55, 95}, 127, 3, 26, 26, 7, 3},
+	{94, "Gengar", []uint{60, 65, 60, 130, 75, 110}, 127, 3, 26, 26, 7, 3},
+	{95, "Onix", []uint{35, 45, 160, 30, 45, 70}, 127, 0, 69, 5, 5, 4},
+	{96, "Drowzee", []uint{60, 48, 45, 43, 90, 42}, 127, 0, 15, 15, 13, 13},
+	{97, "Hypno", []uint{85, 73, 70, 73, 115, 67}, 127, 0, 15, 15, 13, 13},
+	{98, "Krabby", []uint{30, 105, 90, 25, 25, 50}, 127, 0, 52, 75, 10, 10},
+	{99, "Kingler", []uint{55, 130, 115, 50, 50, 75}, 127, 0, 52, 75, 10, 10},
+	{100, "Voltorb", []uint{40, 30, 50, 55, 55, 100}, 255, 0, 43, 9, 12, 12},
+	{101, "Electrode", []uint{60, 50, 70, 80, 80, 140}, 255, 0, 43, 9, 12, 12},
+	{102, "Exeggcute", []uint{60, 40, 80, 60, 45, 40}, 127, 5, 34, 34, 11, 13},
+	{103, "Exeggutor", []uint{95, 95, 85, 125, 65, 55}, 127, 5, 34, 34, 11, 13},
+	{104, "Cubone", []uint{50, 50, 95, 40, 50, 35}, 127, 0, 69, 31, 4, 4},
+	{105, "Marowak", []uint{60, 80, 110, 50, 80, 45}, 127, 0, 69, 31, 4, 4},
+	{106, "Hitmonlee", []uint{50, 120, 53, 35, 110, 87}, 0, 0, 7, 7, 1, 1},
+	{107, "Hitmonchan", []uint{50, 105, 79, 35, 110, 76}, 0, 0, 51, 51, 1, 1},
+	{108, "Lickitung", []uint{90, 55, 75, 60, 75, 30}, 127, 0, 20, 12, 0, 0},
+	{109, "Koffing", []uint{40, 65, 95, 60, 45, 35}, 127, 0, 26, 26, 3, 3},
+	{110, "Weezing", []uint{65, 90, 120, 85, 70, 60}, 127, 0, 26, 26, 3, 3},
+	{111, "Rhyhorn", []uint{80, 85, 95, 30, 30, 25}, 127, 5, 31, 69, 4, 5},
+	{112, "Rhydon", []uint{105, 130, 120, 45, 45, 40}, 127, 5, 31, 69, 4, 5},
+	{113, "Chansey", []uint{250, 5, 5, 35, 105, 50}, 254, 4, 30, 32, 0, 0},
+	{114, "Tangela", []uint{65, 55, 115, 100, 40, 60}, 127, 0, 34, 34, 11, 11},
+	{115, "Kangaskhan", []uint{105, 95, 80, 40, 80, 90}, 254, 0, 48, 48, 0, 0},
+	{116, "Horsea", []uint{30, 40, 70, 70, 25, 60}, 127, 0, 33, 33, 10, 10},
+	{117, "Seadra", []uint{55, 65, 95, 95, 45, 85}, 127, 0, 38, 38, 10, 10},
+	{118, "Goldeen", []uint{45, 67, 60, 35, 50, 63}, 127, 0, 33, 41, 10, 10},
+	{119, "Seaking", []uint{80, 92, 65, 65, 80, 68}, 127, 0, 33, 41, 10, 10},
+	{120, "Staryu", []uint{30, 45, 55, 70, 55, 85}, 255, 5, 35, 30, 10, 10},
+	{121, "Starmie", []uint{60, 75, 85, 100, 85, 115}, 255, 5, 35, 30, 10, 13},
+	{122, "Mr. Mime", []uint{40, 45, 65, 100, 120, 90}, 127, 0, 43, 43, 13, 13},
+	{123, "Scyther", []uint{70, 110, 80, 55, 80, 105}, 127, 0, 68, 68, 6, 2},
+	{124, "Jynx", []uint{65, 50, 35, 115, 95, 95}, 254, 0, 12, 12, 14, 13},
+	{125, "Electabuzz", []uint{65, 83, 57, 95, 85, 105}, 63, 0, 9, 9, 12, 12},
+	{126, "Magmar", []uint{65, 95, 57, 100, 85, 93}, 63, 0, 49, 49, 9, 9},
+	{127, "Pinsir", []uint{65, 125, 100, 55, 70, 85}, 127, 5, 52, 52, 6, 6},
+	{128, "Tauros", []uint{75, 100, 95, 40, 70, 110}, 0, 5, 22, 22, 0, 0},
+	{129, "Magikarp", []uint{20, 10, 55, 15, 20, 80}, 127, 5, 33, 33, 10, 10},
+	{130, "Gyarados", []uint{95, 125, 79, 60, 100, 81}, 127, 5, 22, 22, 10, 2},
+	{131, "Lapras", []uint{130, 85, 80, 85, 95, 60}, 127, 5, 11, 75, 10, 14},
 	{132, "Ditto", []uint{48, 48, 48, 48, 48, 48}, 255, 0, 7, 7, 0, 0},
-	{133, "Eevee", []uint{55, 50, 55, 45, 45, 65}, 31, 0, 50, 50, 0, 0},
-	{134, "Vaporeon", []uint{130, 60, 65, 110, 110, 95}, 31, 0, 11, 11, 10, 10},
-	{135, "Jolteon", []uint{65, 60, 130, 110, 110, 95}, 31, 0, 10, 10, 12, 12},
-	{136, "Flareon", []uint{65, 60, 65, 95, 95, 110}, 31, 0, 18, 18, 9, 9},
-	{137, "Porygon", []uint{65, 70, 40, 85, 85, 75}, 255, 0, 36, 36, 0, 0},
-	{138, "Omanyte", []uint{35, 100, 35, 90, 90, 55}, 31, 0, 33, 75, 5, 10},
-	{139, "Omastar", []uint{70, 125, 55, 115, 115, 70}, 31, 0, 33, 75, 5, 10},
-	{140, "Kabuto", []uint{30, 90, 55, 55, 55, 45}, 31, 0, 33, 4, 5, 10},
-	{141, "Kabutops", []uint{60, 105, 80, 65, 65, 70}, 31, 0, 33, 4, 5, 10},
-	{142, "Aerodactyl", []uint{80, 65, 130, 60, 60, 75}, 31, 5, 69, 46, 5, 2},
-	{143, "Snorlax", []uint{160, 65, 30, 65, 65, 110}, 31, 5, 17, 47, 0, 0},
-	{144, "Articuno", []uint{90, 100, 85, 95, 95, 125}, 255, 5, 46, 46, 14, 2},
-	{145, "Zapdos", []uint{90, 85, 100, 125, 125, 90}, 255, 5, 46, 46, 12, 2},
-	{146, "Moltres", []uint{90, 90, 90, 125, 125, 85}, 255, 5, 46, 46, 9, 2},
-	{147, "Dratini", []uint{41, 45, 50, 50, 50, 50}, 127, 5, 61, 61, 15, 15},
-	{148, "Dragonair", []uint{61, 65, 70, 70, 70, 70}, 127, 5, 61, 61, 15, 15},
-	{149, "Dragonite", []uint{91, 95, 80, 100, 100, 100}, 127, 5, 39, 39, 15, 2},
-	{150, "Mewtwo", []uint{106, 90, 130, 154, 154, 90}, 255, 5, 46, 46, 13, 13},
+	{133, "Eevee", []uint{55, 55, 50, 45, 65, 55}, 31, 0, 50, 50, 0, 0},
+	{134, "Vaporeon", []uint{130, 65, 60, 110, 95, 65}, 31, 0, 11, 11, 10, 10},
+	{135, "Jolteon", []uint{65, 65, 60, 110, 95, 130}, 31, 0, 10, 10, 12, 12},
+	{136, "Flareon", []uint{65, 130, 60, 95, 110, 65}, 31, 0, 18, 18, 9, 9},
+	{137, "Porygon", []uint{65, 60, 70, 85, 75, 40}, 255, 0, 36, 36, 0, 0},
+	{138, "Omanyte", []uint{35, 40, 100, 90, 55, 35}, 31, 0, 33, 75, 5, 10},
+	{139, "Omastar", []uint{70, 60, 125, 115, 70, 55}, 31, 0, 33, 75, 5, 10},
+	{140, "Kabuto", []uint{30, 80, 90, 55, 45, 55}, 31, 0, 33, 4, 5, 10},
+	{141, "Kabutops", []uint{60, 115, 105, 65, 70, 80}, 31, 0, 33, 4, 5, 10},
+	{142, "Aerodactyl", []uint{80, 105, 65, 60, 75, 130}, 31, 5, 69, 46, 5, 2},
+	{143, "Snorlax", []uint{160, 110, 65, 65, 110, 30}, 31, 5, 17, 47, 0, 0},
+	{144, "Articuno", []uint{90, 85, 100, 95, 125, 85}, 255, 5, 46, 46, 14, 2},
+	{145, "Zapdos", []uint{90, 90, 85, 125, 90, 100}, 255, 5, 46, 46, 12, 2},
+	{146, "Moltres", []uint{90, 100, 90, 125, 85, 90}, 255, 5, 46, 46, 9, 2},
+	{147, "Dratini", []uint{41, 64, 45, 50, 50, 50}, 127, 5, 61, 61, 15, 15},
+	{148, "Dragonair", []uint{61, 84, 65, 70, 70, 70}, 127, 5, 61, 61, 15, 15},
+	{149, "Dragonite", []uint{91, 134, 95, 100, 100, 80}, 127, 5, 39, 39, 15, 2},
+	{150, "Mewtwo", []uint{106, 110, 90, 154, 90, 130}, 255, 5, 46, 46, 13, 13},
 	{151, "Mew", []uint{100, 100, 100, 100, 100, 100}, 255, 3, 28, 28, 13, 13},
-	{152, "Chikorita", []uint{45, 65, 45, 49, 49, 65}, 31, 3, 65, 65, 11, 11},
-	{153, "Bayleef", []uint{60, 80, 60, 63, 63, 80}, 31, 3, 65, 65, 11, 11},
-	{154, "Meganium", []uint{80, 100, 80, 83, 83, 100}, 31, 3, 65, 65, 11, 11},
-	{155, "Cyndaquil", []uint{39, 43, 65, 60, 60, 50}, 31, 3, 66, 66, 9, 9},
-	{156, "Quilava", []uint{58, 58, 80, 80, 80, 65}, 31, 3, 66, 66, 9, 9},
-	{157, "Typhlosion", []uint{78, 78, 100, 109, 109, 85}, 31, 3, 66, 66, 9, 9},
-	{158, "Totodile", []uint{50, 64, 43, 44, 44, 48}, 31, 3, 67, 67, 10, 10},
-	{159, "Croconaw", []uint{65, 80, 58, 59, 59, 63}, 31, 3, 67, 67, 10, 10},
-	{160, "Feraligatr", []uint{85, 100, 78, 79, 79, 83}, 31, 3, 67, 67, 10, 10},
-	{161, "Sentret", []uint{35, 34, 20, 35, 35, 45}, 127, 0, 50, 51, 0, 0},
-	{162, "Furret", []uint{85, 64, 90, 45, 45, 55}, 127, 0, 50, 51, 0, 0},
-	{163, "Hoothoot", []uint{60, 30, 50, 36, 36, 56}, 127, 0, 15, 51, 0, 2},
-	{164, "Noctowl", []uint{100, 50, 70, 76, 76, 96}, 127, 0, 15, 51, 0, 2},
-	{165, "Ledyba", []uint{40, 30, 55, 40, 40, 80}, 127, 4, 68, 48, 6, 2},
-	{166, "Ledian", []uint{55, 50, 85, 55, 55, 110}, 127, 4, 68, 48, 6, 2},
-	{167, "Spinarak", []uint{40, 40, 30, 40, 40, 40}, 127, 4, 68, 15, 6, 3},
-	{168, "Ariados", []uint{70, 70, 40, 60, 60, 60}, 127, 4, 68, 15, 6, 3},
-	{169, "Crobat", []uint{85, 80, 130, 70, 70, 80}, 127, 0, 39, 39, 3, 2},
-	{170, "Chinchou", []uint{75, 38, 67, 56, 56, 56}, 127, 5, 10, 35, 10, 12},
-	{171, "Lanturn", []uint{125, 58, 67, 76, 76, 76}, 127, 5, 10, 35, 10, 12},
-	{172, "Pichu", []uint{20, 15, 60, 35, 35, 35}, 127, 0, 9, 9, 12, 12},
-	{173, "Cleffa", []uint{50, 28, 15, 45, 45, 55}, 191, 4, 56, 56, 0, 0},
-	{174, "Igglybuff", []uint{90, 15, 15, 40, 40, 20}, 191, 4, 56, 56, 0, 0},
-	{175, "Togepi", []uint{35, 65, 20, 40, 40, 65}, 31, 4, 55, 32, 0, 0},
-	{176, "Togetic", []uint{55, 85, 40, 80, 80, 105}, 31, 4, 55, 32, 0, 2},
-	{177, "Natu", []uint{40, 45, 70, 70, 70, 45}, 127, 0, 28, 48, 13, 2},
-	{178, "Xatu", []uint{65, 70, 95, 95, 95, 70}, 127, 0, 28, 48, 13, 2},
-	{179, "Mareep", []uint{55, 40, 35, 65, 65, 45}, 127, 3, 9, 9, 12, 12},
-	{180, "Flaaffy", []uint{70, 55, 45, 80, 80, 60}, 127, 3, 9, 9, 12, 12},
-	{181, "Ampharos", []uint{90, 75, 55, 115, 115, 90}, 127, 3, 9, 9, 12, 12},
-	{182, "Bellossom", []uint{75, 85, 50, 90, 90, 100}, 127, 3, 34, 34, 11, 11},
-	{183, "Marill", []uint{70, 50, 40, 20, 20, 50}, 127, 4, 47, 37, 10, 10},
-	{184, "Azumarill", []uint{100, 80, 50, 50, 50, 80}, 127, 4, 47, 37, 10, 10},
-	{185, "Sudowoodo", []uint{70, 115, 30, 30, 30, 65}, 127, 0, 5, 69, 5, 5},
-	{186, "Politoed", []uint{90, 75, 70, 90, 90, 100}, 127, 3, 11, 6, 10, 10},
-	{187, "Hoppip", []uint{35, 40, 50, 35, 35, 55}, 127, 3, 34, 34, 11, 2},
-	{188, "Skiploom", []uint{55, 50, 80, 45, 45, 65}, 127, 3, 34, 34, 11, 2},
-	{189, "Jumpluff", []uint{75, 70, 110, 55, 55, 85}, 127, 3, 34, 34, 11, 2},
-	{190, "Aipom", []uint{55, 55, 85, 40, 40, 55}, 127, 4, 50, 53, 0, 0},
+	{152, "Chikorita", []uint{45, 49, 65, 49, 65, 45}, 31, 3, 65, 65, 11, 11},
+	{153, "Bayleef", []uint{60, 62, 80, 63, 80, 60}, 31, 3, 65, 65, 11, 11},
+	{154, "Meganium", []uint{80, 82, 100, 83, 100, 80}, 31, 3, 65, 65, 11, 11},
+	{155, "Cyndaquil", []uint{39, 52, 43, 60, 50, 65}, 31, 3, 66, 66, 9, 9},
+	{156, "Quilava", []uint{58, 64, 58, 80, 65, 80}, 31, 3, 66, 66, 9, 9},
+	{157, "Typhlosion", []uint{78, 84, 78, 109, 85, 100}, 31, 3, 66, 66, 9, 9},
+	{158, "Totodile", []uint{50, 65, 64, 44, 48, 43}, 31, 3, 67, 67, 10, 10},
+	{159, "Croconaw", []uint{65, 80, 80, 59, 63, 58}, 31, 3, 67, 67, 10, 10},
+	{160, "Feraligatr", []uint{85, 105, 100, 79, 83, 78}, 31, 3, 67, 67, 10, 10},
+	{161, "Sentret", []uint{35, 46, 34, 35, 45, 20}, 127, 0, 50, 51, 0, 0},
+	{162, "Furret", []uint{85, 76, 64, 45, 55, 90}, 127, 0, 50, 51, 0, 0},
+	{163, "Hoothoot", []uint{60, 30, 30, 36, 56, 50}, 127, 0, 15, 51, 0, 2},
+	{164, "Noctowl", []uint{100, 50, 50, 76, 96, 70}, 127, 0, 15, 51, 0, 2},
+	{165, "Ledyba", []uint{40, 20, 30, 40, 80, 55}, 127, 4, 68, 48, 6, 2},
+	{166, "Ledian", []uint{55, 35, 50, 55, 110, 85}, 127, 4, 68, 48, 6, 2},
+	{167, "Spinarak", []uint{40, 60, 40, 40, 40, 30}, 127, 4, 68, 15, 6, 3},
+	{168, "Ariados", []uint{70, 90, 70, 60, 60, 40}, 127, 4, 68, 15, 6, 3},
+	{169, "Crobat", []uint{85, 90, 80, 70, 80, 130}, 127, 0, 39, 39, 3, 2},
+	{170, "Chinchou", []uint{75, 38, 38, 56, 56, 67}, 127, 5, 10, 35, 10, 12},
+	{171, "Lanturn", []uint{125, 58, 58, 76, 76, 67}, 127, 5, 10, 35, 10, 12},
+	{172, "Pichu", []uint{20, 40, 15, 35, 35, 60}, 127, 0, 9, 9, 12, 12},
+	{173, "Cleffa", []uint{50, 25, 28, 45, 55, 15}, 191, 4, 56, 56, 0, 0},
+	{174, "Igglybuff", []uint{90, 30, 15, 40, 20, 15}, 191, 4, 56, 56, 0, 0},
+	{175, "Togepi", []uint{35, 20, 65, 40, 65, 20}, 31, 4, 55, 32, 0, 0},
+	{176, "Togetic", []uint{55, 40, 85, 80, 105, 40}, 31, 4, 55, 32, 0, 2},
+	{177, "Natu", []uint{40, 50, 45, 70, 45, 70}, 127, 0, 28, 48, 13, 2},
+	{178, "Xatu", []uint{65, 75, 70, 95, 70, 95}, 127, 0, 28, 48, 13, 2},
+	{179, "Mareep", []uint{55, 40, 40, 65, 45, 35}, 127, 3, 9, 9, 12, 12},
+	{180, "Flaaffy", []uint{70, 55, 55, 80, 60, 45}, 127, 3, 9, 9, 12, 12},
+	{181, "Ampharos", []uint{90, 75, 75, 115, 90, 55}, 127, 3, 9, 9, 12, 12},
+	{182, "Bellossom", []uint{75, 80, 85, 90, 100, 50}, 127, 3, 34, 34, 11, 11},
+	{183, "Marill", []uint{70, 20, 50, 20, 50, 40}, 127, 4, 47, 37, 10, 10},
+	{184, "Azumarill", []uint{100, 50, 80, 50, 80, 50}, 127, 4, 47, 37, 10, 10},
+	{185, "Sudowoodo", []uint{70, 100, 115, 30, 65, 30}, 127, 0, 5, 69, 5, 5},
+	{186, "Politoed", []uint{90, 75, 75, 90, 100, 70}, 127, 3, 11, 6, 10, 10},
+	{187, "Hoppip", []uint{35, 35, 40, 35, 55, 50}, 127, 3, 34, 34, 11, 2},
+	{188, "Skiploom", []uint{55, 45, 50, 45, 65, 80}, 127, 3, 34, 34, 11, 2},
+	{189, "Jumpluff", []uint{75, 55, 70, 55, 85, 110}, 127, 3, 34, 34, 11, 2},
+	{190, "Aipom", []uint{55, 70, 55, 40, 55, 85}, 127, 4, 50, 53, 0, 0},
 	{191, "Sunkern", []uint{30, 30, 30, 30, 30, 30}, 127, 3, 34, 34, 11, 11},
-	{192, "Sunflora", []uint{75, 55, 30, 105, 105, 85}, 127, 3, 34, 34, 11, 11},
-	{193, "Yanma", []uint{65, 45, 95, 75, 75, 45}, 127, 0, 3, 14, 6, 2},
-	{194, "Wooper", []uint{55, 45, 15, 25, 25, 25}, 127, 0, 6, 11, 10, 4},
-	{195, "Quagsire", []uint{95, 85, 35, 65, 65, 65}, 127, 0, 6, 11, 10, 4},
-	{196, "Espeon", []uint{65, 60, 110, 130, 130, 95}, 31, 0, 28, 28, 13, 13},
-	{197, "Umbreon", []uint{95, 110, 65, 60, 60, 130}, 31, 0, 28, 28, 16, 16},
-	{198, "Murkrow", []uint{60, 42, 91, 85, 85, 42}, 127, 3, 15, 15, 16, 2},
-	{199, "Slowking", []uint{95, 80, 30, 100, 100, 110}, 127, 0, 12, 20, 10, 13},
-	{200, "Misdreavus", []uint{60, 60, 85, 85, 85, 85}, 127, 4, 26, 26, 7, 7},
-	{201, "Unown", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{202, "Wobbuffet", []uint{190, 58, 33, 33, 33, 58}, 127, 0, 23, 23, 13, 13},
-	{203, "Girafarig", []uint{70, 65, 85, 90, 90, 65}, 127, 0, 39, 48, 0, 13},
-	{204, "Pineco", []uint{50, 90, 15, 35, 35, 35}, 127, 0, 5, 5, 6, 6},
-	{205, "Forretress", []uint{75, 140, 40, 60, 60, 60}, 127, 0, 5, 5, 6, 8},
-	{206, "Dunsparce", []uint{100, 70, 45, 65, 65, 65}, 127, 0, 32, 50, 0, 0},
-	{207, "Gligar", []uint{65, 105, 85, 35, 35, 65}, 127, 3, 52, 8, 4, 2},
-	{208, "Steelix", []uint{75, 200, 30, 55, 55, 65}, 127, 0, 69, 5, 8, 4},
-	{209, "Snubbull", []uint{60, 50, 30, 40, 40, 40}, 191, 4, 22, 50, 0, 0},
-	{210, "Granbull", []uint{90, 75, 45, 60, 60, 60}, 191, 4, 22, 22, 0, 0},
-	{211, "Qwilfish", []uint{65, 75, 85, 55, 55, 55}, 127, 0, 38, 33, 10, 3},
-	{212, "Scizor", []uint{70, 100, 65, 55, 55, 80}, 127, 0, 68, 68, 6, 8},
-	{213, "Shuckle", []uint{20, 230, 5, 10, 10, 230}, 127, 3, 5, 5, 6, 5},
-	{214, "Heracross", []uint{80, 75, 85, 40, 40, 95}, 127, 5, 68, 62, 6, 1},
-	{215, "Sneasel", []uint{55, 55, 115, 35, 35, 75}, 127, 3, 39, 51, 16, 14},
-	{216, "Teddiursa", []uint{60, 50, 40, 50, 50, 50}, 127, 0, 53, 53, 0, 0},
-	{217, "Ursaring", []uint{90, 75, 55, 75, 75, 75}, 127, 0, 62, 62, 0, 0},
-	{218, "Slugma", []uint{40, 40, 20, 70, 70, 40}, 127, 0, 40, 49, 9, 9},
-	{219, "Magcargo", []uint{50, 120, 30, 80, 80, 80}, 127, 0, 40, 49, 9, 5},
-	{220, "Swinub", []uint{50, 40, 50, 30, 30, 30}, 127, 5, 12, 12, 14, 4},
-	{221, "Piloswine", []uint{100, 80, 50, 60, 60, 60}, 127, 5, 12, 12, 14, 4},
-	{222, "Corsola", []uint{55, 85, 35, 65, 65, 85}, 191, 4, 55, 30, 10, 5},
-	{223, "Remoraid", []uint{35, 35, 65, 65, 65, 35}, 127, 0, 55, 55, 10, 10},
-	{224, "Octillery", []uint{75, 75, 45, 105, 105, 75}, 127, 0, 21, 21, 10, 10},
-	{225, "Delibird", []uint{45, 45, 75, 65, 65, 45}, 127, 4, 72, 55, 14, 2},
-	{226, "Mantine", []uint{65, 70, 70, 80, 80, 140}, 127, 5, 33, 11, 10, 2},
-	{227, "Skarmory", []uint{65, 140, 70, 40, 40, 70}, 127, 5, 51, 5, 8, 2},
-	{228, "Houndour", []uint{45, 30, 65, 80, 80, 50}, 127, 5, 48, 18, 16, 9},
-	{229, "Houndoom", []uint{75, 50, 95, 110, 110, 80}, 127, 5, 48, 18, 16, 9},
-	{230, "Kingdra", []uint{75, 95, 85, 95, 95, 95}, 127, 0, 33, 33, 10, 15},
-	{231, "Phanpy", []uint{90, 60, 40, 40, 40, 40}, 127, 0, 53, 53, 4, 4},
-	{232, "Donphan", []uint{90, 120, 50, 60, 60, 60}, 127, 0, 5, 5, 4, 4},
-	{233, "Porygon2", []uint{85, 90, 60, 105, 105, 95}, 255, 0, 36, 36, 0, 0},
-	{234, "Stantler", []uint{73, 62, 85, 85, 85, 65}, 127, 5, 22, 22, 0, 0},
-	{235, "Smeargle", []uint{55, 35, 75, 20, 20, 45}, 127, 4, 20, 20, 0, 0},
+	{192, "Sunflora", []uint{75, 75, 55, 105, 85, 30}, 127, 3, 34, 34, 11, 11},
+	{193, "Yanma", []uint{65, 65, 45, 75, 45, 95}, 127, 0, 3, 14, 6, 2},
+	{194, "Wooper", []uint{55, 45, 45, 25, 25, 15}, 127, 0, 6, 11, 10, 4},
+	{195, "Quagsire", []uint{95, 85, 85, 65, 65, 35}, 127, 0, 6, 11, 10, 4},
+	{196, "Espeon", []uint{65, 65, 60, 130, 95, 110}, 31, 0, 28, 28, 13, 13},
+	{197, "Umbreon", []uint{95, 65, 110, 60, 130, 65}, 31, 0, 28, 28, 16, 16},
+	{198, "Murkrow", []uint{60, 85, 42, 85, 42, 91}, 127, 3, 15, 15, 16, 2},
+	{199, "Slowking", []uint{95, 75, 80, 100, 110, 30}, 127, 0, 12, 20, 10, 13},
+	{200, "Misdreavus", []uint{60, 60, 60, 85, 85, 85}, 127, 4, 26, 26, 7, 7},
+	{201, "Unown", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{202, "Wobbuffet", []uint{190, 33, 58, 33, 58, 33}, 127, 0, 23, 23, 13, 13},
+	{203, "Girafarig", []uint{70, 80, 65, 90, 65, 85}, 127, 0, 39, 48, 0, 13},
+	{204, "Pineco", []uint{50, 65, 90, 35, 35, 15}, 127, 0, 5, 5, 6, 6},
+	{205, "Forretress", []uint{75, 90, 140, 60, 60, 40}, 127, 0, 5, 5, 6, 8},
+	{206, "Dunsparce", []uint{100, 70, 70, 65, 65, 45}, 127, 0, 32, 50, 0, 0},
+	{207, "Gligar", []uint{65, 75, 105, 35, 65, 85}, 127, 3, 52, 8, 4, 2},
+	{208, "Steelix", []uint{75, 85, 200, 55, 65, 30}, 127, 0, 69, 5, 8, 4},
+	{209, "Snubbull", []uint{60, 80, 50, 40, 40, 30}, 191, 4, 22, 50, 0, 0},
+	{210, "Granbull", []uint{90, 120, 75, 60, 60, 45}, 191, 4, 22, 22, 0, 0},
+	{211, "Qwilfish", []uint{65, 95, 75, 55, 55, 85}, 127, 0, 38, 33, 10, 3},
+	{212, "Scizor", []uint{70, 130, 100, 55, 80, 65}, 127, 0, 68, 68, 6, 8},
+	{213, "Shuckle", []uint{20, 10, 230, 10, 230, 5}, 127, 3, 5, 5, 6, 5},
+	{214, "Heracross", []uint{80, 125, 75, 40, 95, 85}, 127, 5, 68, 62, 6, 1},
+	{215, "Sneasel", []uint{55, 95, 55, 35, 75, 115}, 127, 3, 39, 51, 16, 14},
+	{216, "Teddiursa", []uint{60, 80, 50, 50, 50, 40}, 127, 0, 53, 53, 0, 0},
+	{217, "Ursaring", []uint{90, 130, 75, 75, 75, 55}, 127, 0, 62, 62, 0, 0},
+	{218, "Slugma", []uint{40, 40, 40, 70, 40, 20}, 127, 0, 40, 49, 9, 9},
+	{219, "Magcargo", []uint{50, 50, 120, 80, 80, 30}, 127, 0, 40, 49, 9, 5},
+	{220, "Swinub", []uint{50, 50, 40, 30, 30, 50}, 127, 5, 12, 12, 14, 4},
+	{221, "Piloswine", []uint{100, 100, 80, 60, 60, 50}, 127, 5, 12, 12, 14, 4},
+	{222, "Corsola", []uint{55, 55, 85, 65, 85, 35}, 191, 4, 55, 30, 10, 5},
+	{223, "Remoraid", []uint{35, 65, 35, 65, 35, 65}, 127, 0, 55, 55, 10, 10},
+	{224, "Octillery", []uint{75, 105, 75, 105, 75, 45}, 127, 0, 21, 21, 10, 10},
+	{225, "Delibird", []uint{45, 55, 45, 65, 45, 75}, 127, 4, 72, 55, 14, 2},
+	{226, "Mantine", []uint{65, 40, 70, 80, 140, 70}, 127, 5, 33, 11, 10, 2},
+	{227, "Skarmory", []uint{65, 80, 140, 40, 70, 70}, 127, 5, 51, 5, 8, 2},
+	{228, "Houndour", []uint{45, 60, 30, 80, 50, 65}, 127, 5, 48, 18, 16, 9},
+	{229, "Houndoom", []uint{75, 90, 50, 110, 80, 95}, 127, 5, 48, 18, 16, 9},
+	{230, "Kingdra", []uint{75, 95, 95, 95, 95, 85}, 127, 0, 33, 33, 10, 15},
+	{231, "Phanpy", []uint{90, 60, 60, 40, 40, 40}, 127, 0, 53, 53, 4, 4},
+	{232, "Donphan", []uint{90, 120, 120, 60, 60, 50}, 127, 0, 5, 5, 4, 4},
+	{233, "Porygon2", []uint{85, 80, 90, 105, 95, 60}, 255, 0, 36, 36, 0, 0},
+	{234, "Stantler", []uint{73, 95, 62, 85, 65, 85}, 127, 5, 22, 22, 0, 0},
+	{235, "Smeargle", []uint{55, 20, 35, 20, 45, 75}, 127, 4, 20, 20, 0, 0},
 	{236, "Tyrogue", []uint{35, 35, 35, 35, 35, 35}, 0, 0, 62, 62, 1, 1},
-	{237, "Hitmontop", []uint{50, 95, 70, 35, 35, 110}, 0, 0, 22, 22, 1, 1},
-	{238, "Smoochum", []uint{45, 15, 65, 85, 85, 65}, 254, 0, 12, 12, 14, 13},
-	{239, "Elekid", []uint{45, 37, 95, 65, 65, 55}, 63, 0, 9, 9, 12, 12},
-	{240, "Magby", []uint{45, 37, 83, 70, 70, 55}, 63, 0, 49, 49, 9, 9},
-	{241, "Miltank", []uint{95, 105, 100, 40, 40, 70}, 254, 5, 47, 47, 0, 0},
-	{242, "Blissey", []uint{255, 10, 55, 75, 75, 135}, 254, 4, 30, 32, 0, 0},
-	{243, "Raikou", []uint{90, 75, 115, 115, 115, 100}, 255, 5, 46, 46, 12, 12},
-	{244, "Entei", []uint{115, 85, 100, 90, 90, 75}, 255, 5, 46, 46, 9, 9},
-	{245, "Suicune", []uint{100, 115, 85, 90, 90, 115}, 255, 5, 46, 46, 10, 10},
-	{246, "Larvitar", []uint{50, 50, 41, 45, 45, 50}, 127, 5, 62, 62, 5, 4},
-	{247, "Pupitar", []uint{70, 70, 51, 65, 65, 70}, 127, 5, 61, 61, 5, 4},
-	{248, "Tyranitar", []uint{100, 110, 61, 95, 95, 100}, 127, 5, 45, 45, 5, 16},
-	{249, "Lugia", []uint{106, 130, 110, 90, 90, 154}, 255, 5, 46, 46, 13, 2},
-	{250, "Ho-Oh", []uint{106, 90, 90, 110, 110, 154}, 255, 5, 46, 46, 9, 2},
+	{237, "Hitmontop", []uint{50, 95, 95, 35, 110, 70}, 0, 0, 22, 22, 1, 1},
+	{238, "Smoochum", []uint{45, 30, 15, 85, 65, 65}, 254, 0, 12, 12, 14, 13},
+	{239, "Elekid", []uint{45, 63, 37, 65, 55, 95}, 63, 0, 9, 9, 12, 12},
+	{240, "Magby", []uint{45, 75, 37, 70, 55, 83}, 63, 0, 49, 49, 9, 9},
+	{241, "Miltank", []uint{95, 80, 105, 40, 70, 100}, 254, 5, 47, 47, 0, 0},
+	{242, "Blissey", []uint{255, 10, 10, 75, 135, 55}, 254, 4, 30, 32, 0, 0},
+	{243, "Raikou", []uint{90, 85, 75, 115, 100, 115}, 255, 5, 46, 46, 12, 12},
+	{244, "Entei", []uint{115, 115, 85, 90, 75, 100}, 255, 5, 46, 46, 9, 9},
+	{245, "Suicune", []uint{100, 75, 115, 90, 115, 85}, 255, 5, 46, 46, 10, 10},
+	{246, "Larvitar", []uint{50, 64, 50, 45, 50, 41}, 127, 5, 62, 62, 5, 4},
+	{247, "Pupitar", []uint{70, 84, 70, 65, 70, 51}, 127, 5, 61, 61, 5, 4},
+	{248, "Tyranitar", []uint{100, 134, 110, 95, 100, 61}, 127, 5, 45, 45, 5, 16},
+	{249, "Lugia", []uint{106, 90, 130, 90, 154, 110}, 255, 5, 46, 46, 13, 2},
+	{250, "Ho-Oh", []uint{106, 130, 90, 110, 154, 90}, 255, 5, 46, 46, 9, 2},
 	{251, "Celebi", []uint{100, 100, 100, 100, 100, 100}, 255, 3, 30, 30, 13, 11},
-	{252, "Unown-B", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{253, "Unown-C", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{254, "Unown-D", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{255, "Unown-E", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{256, "Unown-F", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{257, "Unown-G", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{258, "Unown-H", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{259, "Unown-I", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{260, "Unown-J", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{261, "Unown-K", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{262, "Unown-L", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{263, "Unown-M", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{264, "Unown-N", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{265, "Unown-O", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{266, "Unown-P", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{267, "Unown-Q", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{268, "Unown-R", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{269, "Unown-S", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{270, "Unown-T", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{271, "Unown-U", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{272, "Unown-V", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{273, "Unown-W", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{274, "Unown-X", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{275, "Unown-Y", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{276, "Unown-Z", []uint{48, 48, 48, 72, 72, 48}, 255, 0, 26, 26, 13, 13},
-	{277, "Treecko", []uint{40, 35, 70, 65, 65, 55}, 31, 3, 65, 65, 11, 11},
-	{278, "Grovyle", []uint{50, 45, 95, 85, 85, 65}, 31, 3, 65, 65, 11, 11},
-	{279, "Sceptile", []uint{70, 65, 120, 105, 105, 85}, 31, 3, 65, 65, 11, 11},
-	{280, "Torchic", []uint{45, 40, 45, 70, 70, 50}, 31, 3, 66, 66, 9, 9},
-	{281, "Combusken", []uint{60, 60, 55, 85, 85, 60}, 31, 3, 66, 66, 9, 1},
-	{282, "Blaziken", []uint{80, 70, 80, 110, 110, 70}, 31, 3, 66, 66, 9, 1},
-	{283, "Mudkip", []uint{50, 50, 40, 50, 50, 50}, 31, 3, 67, 67, 10, 10},
-	{284, "Marshtomp", []uint{70, 70, 50, 60, 60, 70}, 31, 3, 67, 67, 10, 4},
-	{285, "Swampert", []uint{100, 90, 60, 85, 85, 90}, 31, 3, 67, 67, 10, 4},
-	{286, "Poochyena", []uint{35, 35, 35, 30, 30, 30}, 127, 0, 50, 50, 16, 16},
-	{287, "Mightyena", []uint{70, 70, 70, 60, 60, 60}, 127, 0, 22, 22, 16, 16},
-	{288, "Zigzagoon", []uint{38, 41, 60, 30, 30, 41}, 127, 0, 53, 53, 0, 0},
-	{289, "Linoone", []uint{78, 61, 100, 50, 50, 61}, 127, 0, 53, 53, 0, 0},
-	{290, "Wurmple", []uint{45, 35, 20, 20, 20, 30}, 127, 0, 19, 19, 6, 6},
-	{291, "Silcoon", []uint{50, 55, 15, 25, 25, 25}, 127, 0, 61, 61, 6, 6},
-	{292, "Beautifly", []uint{60, 50, 65, 90, 90, 50}, 127, 0, 68, 68, 6, 2},
-	{293, "Cascoon", []uint{50, 55, 15, 25, 25, 25}, 127, 0, 61, 61, 6, 6},
-	{294, "Dustox", []uint{60, 70, 65, 50, 50, 90}, 127, 0, 19, 19, 6, 3},
-	{295, "Lotad", []uint{40, 30, 30, 40, 40, 50}, 127, 3, 33, 44, 10, 11},
-	{296, "Lombre", []uint{60, 50, 50, 60, 60, 70}, 127, 3, 33, 44, 10, 11},
-	{297, "Ludicolo", []uint{80, 70, 70, 90, 90, 100}, 127, 3, 33, 44, 10, 11},
-	{298, "Seedot", []uint{40, 50, 30, 30, 30, 30}, 127, 3, 34, 48, 11, 11},
-	{299, "Nuzleaf", []uint{70, 40, 60, 60, 60, 40}, 127, 3, 34, 48, 11, 16},
-	{300, "Shiftry", []uint{90, 60, 80, 90, 90, 60}, 127, 3, 34, 48, 11, 16},
-	{302, "Ninjask", []uint{61, 45, 160, 50, 50, 50}, 127, 1, 3, 3, 6, 2},
-	{301, "Nincada", []uint{31, 90, 40, 30, 30, 30}, 127, 1, 14, 14, 6, 4},
-	{303, "Shedinja", []uint{1, 45, 40, 30, 30, 30}, 255, 1, 25, 25, 6, 7},
-	{304, "Taillow", []uint{40, 30, 85, 30, 30, 30}, 127, 3, 62, 62, 0, 2},
-	{305, "Swellow", []uint{60, 60, 125, 50, 50, 50}, 127, 3, 62, 62, 0, 2},
-	{306, "Shroomish", []uint{60, 60, 35, 40, 40, 60}, 127, 2, 27, 27, 11, 11},
-	{307, "Breloom", []uint{60, 80, 70, 60, 60, 60}, 127, 2, 27, 27, 11, 1},
+	{252, "Unown-B", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{253, "Unown-C", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{254, "Unown-D", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{255, "Unown-E", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{256, "Unown-F", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{257, "Unown-G", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{258, "Unown-H", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{259, "Unown-I", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{260, "Unown-J", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{261, "Unown-K", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{262, "Unown-L", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{263, "Unown-M", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{264, "Unown-N", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{265, "Unown-O", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{266, "Unown-P", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{267, "Unown-Q", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{268, "Unown-R", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{269, "Unown-S", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{270, "Unown-T", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{271, "Unown-U", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{272, "Unown-V", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{273, "Unown-W", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{274, "Unown-X", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{275, "Unown-Y", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{276, "Unown-Z", []uint{48, 72, 48, 72, 48, 48}, 255, 0, 26, 26, 13, 13},
+	{277, "Treecko", []uint{40, 45, 35, 65, 55, 70}, 31, 3, 65, 65, 11, 11},
+	{278, "Grovyle", []uint{50, 65, 45, 85, 65, 95}, 31, 3, 65, 65, 11, 11},
+	{279, "Sceptile", []uint{70, 85, 65, 105, 85, 120}, 31, 3, 65, 65, 11, 11},
+	{280, "Torchic", []uint{45, 60, 40, 70, 50, 45}, 31, 3, 66, 66, 9, 9},
+	{281, "Combusken", []uint{60, 85, 60, 85, 60, 55}, 31, 3, 66, 66, 9, 1},
+	{282, "Blaziken", []uint{80, 120, 70, 110, 70, 80}, 31, 3, 66, 66, 9, 1},
+	{283, "Mudkip", []uint{50, 70, 50, 50, 50, 40}, 31, 3, 67, 67, 10, 10},
+	{284, "Marshtomp", []uint{70, 85, 70, 60, 70, 50}, 31, 3, 67, 67, 10, 4},
+	{285, "Swampert", []uint{100, 110, 90, 85, 90, 60}, 31, 3, 67, 67, 10, 4},
+	{286, "Poochyena", []uint{35, 55, 35, 30, 30, 35}, 127, 0, 50, 50, 16, 16},
+	{287, "Mightyena", []uint{70, 90, 70, 60, 60, 70}, 127, 0, 22, 22, 16, 16},
+	{288, "Zigzagoon", []uint{38, 30, 41, 30, 41, 60}, 127, 0, 53, 53, 0, 0},
+	{289, "Linoone", []uint{78, 70, 61, 50, 61, 100}, 127, 0, 53, 53, 0, 0},
+	{290, "Wurmple", []uint{45, 45, 35, 20, 30, 20}, 127, 0, 19, 19, 6, 6},
+	{291, "Silcoon", []uint{50, 35, 55, 25, 25, 15}, 127, 0, 61, 61, 6, 6},
+	{292, "Beautifly", []uint{60, 70, 50, 90, 50, 65}, 127, 0, 68, 68, 6, 2},
+	{293, "Cascoon", []uint{50, 35, 55, 25, 25, 15}, 127, 0, 61, 61, 6, 6},
+	{294, "Dustox", []uint{60, 50, 70, 50, 90, 65}, 127, 0, 19, 19, 6, 3},
+	{295, "Lotad", []uint{40, 30, 30, 40, 50, 30}, 127, 3, 33, 44, 10, 11},
+	{296, "Lombre", []uint{60, 50, 50, 60, 70, 50}, 127, 3, 33, 44, 10, 11},
+	{297, "Ludicolo", []uint{80, 70, 70, 90, 100, 70}, 127, 3, 33, 44, 10, 11},
+	{298, "Seedot", []uint{40, 40, 50, 30, 30, 30}, 127, 3, 34, 48, 11, 11},
+	{299, "Nuzleaf", []uint{70, 70, 40, 60, 40, 60}, 127, 3, 34, 48, 11, 16},
+	{300, "Shiftry", []uint{90, 100, 60, 90, 60, 80}, 127, 3, 34, 48, 11, 16},
+	{302, "Ninjask", []uint{61, 90, 45, 50, 50, 160}, 127, 1, 3, 3, 6, 2},
+	{301, "Nincada", []uint{31, 45, 90, 30, 30, 40}, 127, 1, 14, 14, 6, 4},
+	{303, "Shedinja", []uint{1, 90, 45, 30, 30, 40}, 255, 1, 25, 25, 6, 7},
+	{304, "Taillow", []uint{40, 55, 30, 30, 30, 85}, 127, 3, 62, 62, 0, 2},
+	{305, "Swellow", []uint{60, 85, 60, 50, 50, 125}, 127, 3, 62, 62, 0, 2},
+	{306, "Shroomish", []uint{60, 40, 60, 40, 60, 35}, 127, 2, 27, 27, 11, 11},
+	{307, "Breloom", []uint{60, 130, 80, 60, 60, 70}, 127, 2, 27, 27, 11, 1},
 	{308, "Spinda", []uint{60, 60, 60, 60, 60, 60}, 127, 4, 20, 20, 0, 0},
-	{309, "Wingull", []uint{40, 30, 85, 55, 55, 30}, 127, 0, 51, 51, 10, 2},
-	{310, "Pelipper", []uint{60, 100, 65, 85, 85, 70}, 127, 0, 51, 51, 10, 2},
-	{311, "Surskit", []uint{40, 32, 65, 50, 50, 52}, 127, 0, 33, 33, 6, 10},
-	{312, "Masquerain", []uint{70, 62, 60, 80, 80, 82}, 127, 0, 22, 22, 6, 2},
-	{313, "Wailmer", []uint{130, 35, 60, 70, 70, 35}, 127, 2, 41, 12, 10, 10},
-	{314, "Wailord", []uint{170, 45, 60, 90, 90, 45}, 127, 2, 41, 12, 10, 10},
-	{315, "Skitty", []uint{50, 45, 50, 35, 35, 35}, 191, 4, 56, 56, 0, 0},
-	{316, "Delcatty", []uint{70, 65, 70, 55, 55, 55}, 191, 4, 56, 56, 0, 0},
-	{317, "Kecleon", []uint{60, 70, 40, 60, 60, 120}, 127, 3, 16, 16, 0, 0},
-	{318, "Baltoy", []uint{40, 55, 55, 40, 40, 70}, 255, 0, 26, 26, 4, 13},
-	{319, "Claydol", []uint{60, 105, 75, 70, 70, 120}, 255, 0, 26, 26, 4, 13},
-	{320, "Nosepass", []uint{30, 135, 30, 45, 45, 90}, 127, 0, 5, 42, 5, 5},
-	{321, "Torkoal", []uint{70, 140, 20, 85, 85, 70}, 127, 0, 73, 73, 9, 9},
-	{322, "Sableye", []uint{50, 75, 50, 65, 65, 65}, 127, 3, 51, 51, 16, 7},
-	{323, "Barboach", []uint{50, 43, 60, 46, 46, 41}, 127, 0, 12, 12, 10, 4},
-	{324, "Whiscash", []uint{110, 73, 60, 76, 76, 71}, 127, 0, 12, 12, 10, 4},
-	{325, "Luvdisc", []uint{43, 55, 97, 40, 40, 65}, 191, 4, 33, 33, 10, 10},
-	{326, "Corphish", []uint{43, 65, 35, 50, 50, 35}, 127, 2, 52, 75, 10, 10},
-	{327, "Crawdaunt", []uint{63, 85, 55, 90, 90, 55}, 127, 2, 52, 75, 10, 16},
-	{328, "Feebas", []uint{20, 20, 80, 10, 10, 55}, 127, 1, 33, 33, 10, 10},
-	{329, "Milotic", []uint{95, 79, 81, 100, 100, 125}, 127, 1, 63, 63, 10, 10},
-	{330, "Carvanha", []uint{45, 20, 65, 65, 65, 20}, 127, 5, 24, 24, 10, 16},
-	{331, "Sharpedo", []uint{70, 40, 95, 95, 95, 40}, 127, 5, 24, 24, 10, 16},
-	{332, "Trapinch", []uint{45, 45, 10, 45, 45, 45}, 127, 3, 52, 71, 4, 4},
-	{333, "Vibrava", []uint{50, 50, 70, 50, 50, 50}, 127, 3, 26, 26, 4, 15},
-	{334, "Flygon", []uint{80, 80, 100, 80, 80, 80}, 127, 3, 26, 26, 4, 15},
-	{335, "Makuhita", []uint{72, 30, 25, 20, 20, 30}, 63, 2, 47, 62, 1, 1},
-	{336, "Hariyama", []uint{144, 60, 50, 40, 40, 60}, 63, 2, 47, 62, 1, 1},
-	{337, "Electrike", []uint{40, 40, 65, 65, 65, 40}, 127, 5, 9, 31, 12, 12},
-	{338, "Manectric", []uint{70, 60, 105, 105, 105, 60}, 127, 5, 9, 31, 12, 12},
-	{339, "Numel", []uint{60, 40, 35, 65, 65, 45}, 127, 0, 12, 12, 9, 4},
-	{340, "Camerupt", []uint{70, 70, 40, 105, 105, 75}, 127, 0, 40, 40, 9, 4},
-	{341, "Spheal", []uint{70, 50, 25, 55, 55, 50}, 127, 3, 47, 47, 14, 10},
-	{342, "Sealeo", []uint{90, 70, 45, 75, 75, 70}, 127, 3, 47, 47, 14, 10},
-	{343, "Walrein", []uint{110, 90, 65, 95, 95, 90}, 127, 3, 47, 47, 14, 10},
-	{344, "Cacnea", []uint{50, 40, 35, 85, 85, 40}, 127, 3, 8, 8, 11, 11},
-	{345, "Cacturne", []uint{70, 60, 55, 115, 115, 60}, 127, 3, 8, 8, 11, 16},
+	{309, "Wingull", []uint{40, 30, 30, 55, 30, 85}, 127, 0, 51, 51, 10, 2},
+	{310, "Pelipper", []uint{60, 50, 100, 85, 70, 65}, 127, 0, 51, 51, 10, 2},
+	{311, "Surskit", []uint{40, 30, 32, 50, 52, 65}, 127, 0, 33, 33, 6, 10},
+	{312, "Masquerain", []uint{70, 60, 62, 80, 82, 60}, 127, 0, 22, 22, 6, 2},
+	{313, "Wailmer", []uint{130, 70, 35, 70, 35, 60}, 127, 2, 41, 12, 10, 10},
+	{314, "Wailord", []uint{170, 90, 45, 90, 45, 60}, 127, 2, 41, 12, 10, 10},
+	{315, "Skitty", []uint{50, 45, 45, 35, 35, 50}, 191, 4, 56, 56, 0, 0},
+	{316, "Delcatty", []uint{70, 65, 65, 55, 55, 70}, 191, 4, 56, 56, 0, 0},
+	{317, "Kecleon", []uint{60, 90, 70, 60, 120, 40}, 127, 3, 16, 16, 0, 0},
+	{318, "Baltoy", []uint{40, 40, 55, 40, 70, 55}, 255, 0, 26, 26, 4, 13},
+	{319, "Claydol", []uint{60, 70, 105, 70, 120, 75}, 255, 0, 26, 26, 4, 13},
+	{320, "Nosepass", []uint{30, 45, 135, 45, 90, 30}, 127, 0, 5, 42, 5, 5},
+	{321, "Torkoal", []uint{70, 85, 140, 85, 70, 20}, 127, 0, 73, 73, 9, 9},
+	{322, "Sableye", []uint{50, 75, 75, 65, 65, 50}, 127, 3, 51, 51, 16, 7},
+	{323, "Barboach", []uint{50, 48, 43, 46, 41, 60}, 127, 0, 12, 12, 10, 4},
+	{324, "Whiscash", []uint{110, 78, 73, 76, 71, 60}, 127, 0, 12, 12, 10, 4},
+	{325, "Luvdisc", []uint{43, 30, 55, 40, 65, 97}, 191, 4, 33, 33, 10, 10},
+	{326, "Corphish", []uint{43, 80, 65, 50, 35, 35}, 127, 2, 52, 75, 10, 10},
+	{327, "Crawdaunt", []uint{63, 120, 85, 90, 55, 55}, 127, 2, 52, 75, 10, 16},
+	{328, "Feebas", []uint{20, 15, 20, 10, 55, 80}, 127, 1, 33, 33, 10, 10},
+	{329, "Milotic", []uint{95, 60, 79, 100, 125, 81}, 127, 1, 63, 63, 10, 10},
+	{330, "Carvanha", []uint{45, 90, 20, 65, 20, 65}, 127, 5, 24, 24, 10, 16},
+	{331, "Sharpedo", []uint{70, 120, 40, 95, 40, 95}, 127, 5, 24, 24, 10, 16},
+	{332, "Trapinch", []uint{45, 100, 45, 45, 45, 10}, 127, 3, 52, 71, 4, 4},
+	{333, "Vibrava", []uint{50, 70, 50, 50, 50, 70}, 127, 3, 26, 26, 4, 15},
+	{334, "Flygon", []uint{80, 100, 80, 80, 80, 100}, 127, 3, 26, 26, 4, 15},
+	{335, "Makuhita", []uint{72, 60, 30, 20, 30, 25}, 63, 2, 47, 62, 1, 1},
+	{336, "Hariyama", []uint{144, 120, 60, 40, 60, 50}, 63, 2, 47, 62, 1, 1},
+	{337, "Electrike", []uint{40, 45, 40, 65, 40, 65}, 127, 5, 9, 31, 12, 12},
+	{338, "Manectric", []uint{70, 75, 60, 105, 60, 105}, 127, 5, 9, 31, 12, 12},
+	{339, "Numel", []uint{60, 60, 40, 65, 45, 35}, 127, 0, 12, 12, 9, 4},
+	{340, "Camerupt", []uint{70, 100, 70, 105, 75, 40}, 127, 0, 40, 40, 9, 4},
+	{341, "Spheal", []uint{70, 40, 50, 55, 50, 25}, 127, 3, 47, 47, 14, 10},
+	{342, "Sealeo", []uint{90, 60, 70, 75, 70, 45}, 127, 3, 47, 47, 14, 10},
+	{343, "Walrein", []uint{110, 80, 90, 95, 90, 65}, 127, 3, 47, 47, 14, 10},
+	{344, "Cacnea", []uint{50, 85, 40, 85, 40, 35}, 127, 3, 8, 8, 11, 11},
+	{345, "Cacturne", []uint{70, 115, 60, 115, 60, 55}, 127, 3, 8, 8, 11, 16},
 	{346, "Snorunt", []uint{50, 50, 50, 50, 50, 50}, 127, 0, 39, 39, 14, 14},
 	{347, "Glalie", []uint{80, 80, 80, 80, 80, 80}, 127, 0, 39, 39, 14, 14},
-	{348, "Lunatone", []uint{70, 65, 70, 95, 95, 85}, 255, 4, 26, 26, 5, 13},
-	{349, "Solrock", []uint{70, 85, 70, 55, 55, 65}, 255, 4, 26, 26, 5, 13},
-	{350, "Azurill", []uint{50, 40, 20, 20, 20, 40}, 191, 4, 47, 37, 0, 0},
-	{351, "Spoink", []uint{60, 35, 60, 70, 70, 80}, 127, 4, 47, 20, 13, 13},
-	{352, "Grumpig", []uint{80, 65, 80, 90, 90, 110}, 127, 4, 47, 20, 13, 13},
-	{353, "Plusle", []uint{60, 40, 95, 85, 85, 75}, 127, 0, 57, 57, 12, 12},
-	{354, "Minun", []uint{60, 50, 95, 75, 75, 85}, 127, 0, 58, 58, 12, 12},
-	{355, "Mawile", []uint{50, 85, 50, 55, 55, 55}, 127, 4, 52, 22, 8, 8},
-	{356, "Meditite", []uint{30, 55, 60, 40, 40, 55}, 127, 0, 74, 74, 1, 13},
-	{357, "Medicham", []uint{60, 75, 80, 60, 60, 75}, 127, 0, 74, 74, 1, 13},
-	{358, "Swablu", []uint{45, 60, 50, 40, 40, 75}, 127, 1, 30, 30, 0, 2},
-	{359, "Altaria", []uint{75, 90, 80, 70, 70, 105}, 127, 1, 30, 30, 15, 2},
-	{360, "Wynaut", []uint{95, 48, 23, 23, 23, 48}, 127, 0, 23, 23, 13, 13},
-	{361, "Duskull", []uint{20, 90, 25, 30, 30, 90}, 127, 4, 26, 26, 7, 7},
-	{362, "Dusclops", []uint{40, 130, 25, 60, 60, 130}, 127, 4, 46, 46, 7, 7},
-	{363, "Roselia", []uint{50, 45, 65, 100, 100, 80}, 127, 3, 30, 38, 11, 3},
-	{364, "Slakoth", []uint{60, 60, 30, 35, 35, 35}, 127, 5, 54, 54, 0, 0},
-	{365, "Vigoroth", []uint{80, 80, 90, 55, 55, 55}, 127, 5, 72, 72, 0, 0},
-	{366, "Slaking", []uint{150, 100, 100, 95, 95, 65}, 127, 5, 54, 54, 0, 0},
-	{367, "Gulpin", []uint{70, 53, 40, 43, 43, 53}, 127, 2, 64, 60, 3, 3},
-	{368, "Swalot", []uint{100, 83, 55, 73, 73, 83}, 127, 2, 64, 60, 3, 3},
-	{369, "Tropius", []uint{99, 83, 51, 72, 72, 87}, 127, 5, 34, 34, 11, 2},
-	{370, "Whismur", []uint{64, 23, 28, 51, 51, 23}, 127, 3, 43, 43, 0, 0},
-	{371, "Loudred", []uint{84, 43, 48, 71, 71, 43}, 127, 3, 43, 43, 0, 0},
-	{372, "Exploud", []uint{104, 63, 68, 91, 91, 63}, 127, 3, 43, 43, 0, 0},
-	{373, "Clamperl", []uint{35, 85, 32, 74, 74, 55}, 127, 1, 75, 75, 10, 10},
-	{374, "Huntail", []uint{55, 105, 52, 94, 94, 75}, 127, 1, 33, 33, 10, 10},
-	{375, "Gorebyss", []uint{55, 105, 52, 114, 114, 75}, 127, 1, 33, 33, 10, 10},
-	{376, "Absol", []uint{65, 60, 75, 75, 75, 60}, 127, 3, 46, 46, 16, 16},
-	{377, "Shuppet", []uint{44, 35, 45, 63, 63, 33}, 127, 4, 15, 15, 7, 7},
-	{378, "Banette", []uint{64, 65, 65, 83, 83, 63}, 127, 4, 15, 15, 7, 7},
-	{379, "Seviper", []uint{73, 60, 65, 100, 100, 60}, 127, 2, 61, 61, 3, 3},
-	{380, "Zangoose", []uint{73, 60, 90, 60, 60, 60}, 127, 1, 17, 17, 0, 0},
-	{381, "Relicanth", []uint{100, 130, 55, 45, 45, 65}, 31, 5, 33, 69, 10, 5},
-	{382, "Aron", []uint{50, 100, 30, 40, 40, 40}, 127, 5, 5, 69, 8, 5},
-	{383, "Lairon", []uint{60, 140, 40, 50, 50, 50}, 127, 5, 5, 69, 8, 5},
-	{384, "Aggron", []uint{70, 180, 50, 60, 60, 60}, 127, 5, 5, 69, 8, 5},
+	{348, "Lunatone", []uint{70, 55, 65, 95, 85, 70}, 255, 4, 26, 26, 5, 13},
+	{349, "Solrock", []uint{70, 95, 85, 55, 65, 70}, 255, 4, 26, 26, 5, 13},
+	{350, "Azurill", []uint{50, 20, 40, 20, 40, 20}, 191, 4, 47, 37, 0, 0},
+	{351, "Spoink", []uint{60, 25, 35, 70, 80, 60}, 127, 4, 47, 20, 13, 13},
+	{352, "Grumpig", []uint{80, 45, 65, 90, 110, 80}, 127, 4, 47, 20, 13, 13},
+	{353, "Plusle", []uint{60, 50, 40, 85, 75, 95}, 127, 0, 57, 57, 12, 12},
+	{354, "Minun", []uint{60, 40, 50, 75, 85, 95}, 127, 0, 58, 58, 12, 12},
+	{355, "Mawile", []uint{50, 85, 85, 55, 55, 50}, 127, 4, 52, 22, 8, 8},
+	{356, "Meditite", []uint{30, 40, 55, 40, 55, 60}, 127, 0, 74, 74, 1, 13},
+	{357, "Medicham", []uint{60, 60, 75, 60, 75, 80}, 127, 0, 74, 74, 1, 13},
+	{358, "Swablu", []uint{45, 40, 60, 40, 75, 50}, 127, 1, 30, 30, 0, 2},
+	{359, "Altaria", []uint{75, 70, 90, 70, 105, 80}, 127, 1, 30, 30, 15, 2},
+	{360, "Wynaut", []uint{95, 23, 48, 23, 48, 23}, 127, 0, 23, 23, 13, 13},
+	{361, "Duskull", []uint{20, 40, 90, 30, 90, 25}, 127, 4, 26, 26, 7, 7},
+	{362, "Dusclops", []uint{40, 70, 130, 60, 130, 25}, 127, 4, 46, 46, 7, 7},
+	{363, "Roselia", []uint{50, 60, 45, 100, 80, 65}, 127, 3, 30, 38, 11, 3},
+	{364, "Slakoth", []uint{60, 60, 60, 35, 35, 30}, 127, 5, 54, 54, 0, 0},
+	{365, "Vigoroth", []uint{80, 80, 80, 55, 55, 90}, 127, 5, 72, 72, 0, 0},
+	{366, "Slaking", []uint{150, 160, 100, 95, 65, 100}, 127, 5, 54, 54, 0, 0},
+	{367, "Gulpin", []uint{70, 43, 53, 43, 53, 40}, 127, 2, 64, 60, 3, 3},
+	{368, "Swalot", []uint{100, 73, 83, 73, 83, 55}, 127, 2, 64, 60, 3, 3},
+	{369, "Tropius", []uint{99, 68, 83, 72, 87, 51}, 127, 5, 34, 34, 11, 2},
+	{370, "Whismur", []uint{64, 51, 23, 51, 23, 28}, 127, 3, 43, 43, 0, 0},
+	{371, "Loudred", []uint{84, 71, 43, 71, 43, 48}, 127, 3, 43, 43, 0, 0},
+	{372, "Exploud", []uint{104, 91, 63, 91, 63, 68}, 127, 3, 43, 43, 0, 0},
+	{373, "Clamperl", []uint{35, 64, 85, 74, 55, 32}, 127, 1, 75, 75, 10, 10},
+	{374, "Huntail", []uint{55, 104, 105, 94, 75, 52}, 127, 1, 33, 33, 10, 10},
+	{375, "Gorebyss", []uint{55, 84, 105, 114, 75, 52}, 127, 1, 33, 33, 10, 10},
+	{376, "Absol", []uint{65, 130, 60, 75, 60, 75}, 127, 3, 46, 46, 16, 16},
+	{377, "Shuppet", []uint{44, 75, 35, 63, 33, 45}, 127, 4, 15, 15, 7, 7},
+	{378, "Banette", []uint{64, 115, 65, 83, 63, 65}, 127, 4, 15, 15, 7, 7},
+	{379, "Seviper", []uint{73, 100, 60, 100, 60, 65}, 127, 2, 61, 61, 3, 3},
+	{380, "Zangoose", []uint{73, 115, 60, 60, 60, 90}, 127, 1, 17, 17, 0, 0},
+	{381, "Relicanth", []uint{100, 90, 130, 45, 65, 55}, 31, 5, 33, 69, 10, 5},
+	{382, "Aron", []uint{50, 70, 100, 40, 40, 30}, 127, 5, 5, 69, 8, 5},
+	{383, "Lairon", []uint{60, 90, 140, 50, 50, 40}, 127, 5, 5, 69, 8, 5},
+	{384, "Aggron", []uint{70, 110, 180, 60, 60, 50}, 127, 5, 5, 69, 8, 5},
 	{385, "Castform", []uint{70, 70, 70, 70, 70, 70}, 127, 0, 59, 59, 0, 0},
-	{386, "Volbeat", []uint{65, 55, 85, 47, 47, 75}, 0, 1, 35, 68, 6, 6},
-	{387, "Illumise", []uint{65, 55, 85, 73, 73, 75}, 254, 2, 12, 12, 6, 6},
-	{388, "Lileep", []uint{66, 77, 23, 61, 61, 87}, 31, 1, 21, 21, 5, 11},
-	{389, "Cradily", []uint{86, 97, 43, 81, 81, 107}, 31, 1, 21, 21, 5, 11},
-	{390, "Anorith", []uint{45, 50, 75, 40, 40, 50}, 31, 1, 4, 4, 5, 6},
-	{391, "Armaldo", []uint{75, 100, 45, 70, 70, 80}, 31, 1, 4, 4, 5, 6},
-	{392, "Ralts", []uint{28, 25, 40, 45, 45, 35}, 127, 5, 28, 36, 13, 13},
-	{393, "Kirlia", []uint{38, 35, 50, 65, 65, 55}, 127, 5, 28, 36, 13, 13},
-	{394, "Gardevoir", []uint{68, 65, 80, 125, 125, 115}, 127, 5, 28, 36, 13, 13},
-	{395, "Bagon", []uint{45, 60, 50, 40, 40, 30}, 127, 5, 69, 69, 15, 15},
-	{396, "Shelgon", []uint{65, 100, 50, 60, 60, 50}, 127, 5, 69, 69, 15, 15},
-	{397, "Salamence", []uint{95, 80, 100, 110, 110, 80}, 127, 5, 22, 22, 15, 2},
-	{398, "Beldum", []uint{40, 80, 30, 35, 35, 60}, 255, 5, 29, 29, 8, 13},
-	{399, "Metang", []uint{60, 100, 50, 55, 55, 80}, 255, 5, 29, 29, 8, 13},
-	{400, "Metagross", []uint{80, 130, 70, 95, 95, 90}, 255, 5, 29, 29, 8, 13},
-	{401, "Regirock", []uint{80, 200, 50, 50, 50, 100}, 255, 5, 29, 29, 5, 5},
-	{402, "Regice", []uint{80, 100, 50, 100, 100, 200}, 255, 5, 29, 29, 14, 14},
-	{403, "Registeel", []uint{80, 150, 50, 75, 75, 150}, 255, 5, 29, 29, 8, 8},
-	{404, "Latias", []uint{80, 90, 110, 110, 110, 130}, 254, 5, 26, 26, 15, 13},
-	{405, "Latios", []uint{80, 80, 110, 130, 130, 110}, 0, 5, 26, 26, 15, 13},
-	{406, "Kyogre", []uint{100, 90, 90, 150, 150, 140}, 255, 5, 2, 2, 10, 10},
-	{407, "Groudon", []uint{100, 140, 90, 100, 100, 90}, 255, 5, 70, 70, 4, 4},
-	{408, "Rayquaza", []uint{105, 90, 95, 150, 150, 90}, 255, 5, 76, 76, 15, 2},
+	{386, "Volbeat", []uint{65, 73, 55, 47, 75, 85}, 0, 1, 35, 68, 6, 6},
+	{387, "Illumise", []uint{65, 47, 55, 73, 75, 85}, 254, 2, 12, 12, 6, 6},
+	{388, "Lileep", []uint{66, 41, 77, 61, 87, 23}, 31, 1, 21, 21, 5, 11},
+	{389, "Cradily", []uint{86, 81, 97, 81, 107, 43}, 31, 1, 21, 21, 5, 11},
+	{390, "Anorith", []uint{45, 95, 50, 40, 50, 75}, 31, 1, 4, 4, 5, 6},
+	{391, "Armaldo", []uint{75, 125, 100, 70, 80, 45}, 31, 1, 4, 4, 5, 6},
+	{392, "Ralts", []uint{28, 25, 25, 45, 35, 40}, 127, 5, 28, 36, 13, 13},
+	{393, "Kirlia", []uint{38, 35, 35, 65, 55, 50}, 127, 5, 28, 36, 13, 13},
+	{394, "Gardevoir", []uint{68, 65, 65, 125, 115, 80}, 127, 5, 28, 36, 13, 13},
+	{395, "Bagon", []uint{45, 75, 60, 40, 30, 50}, 127, 5, 69, 69, 15, 15},
+	{396, "Shelgon", []uint{65, 95, 100, 60, 50, 50}, 127, 5, 69, 69, 15, 15},
+	{397, "Salamence", []uint{95, 135, 80, 110, 80, 100}, 127, 5, 22, 22, 15, 2},
+	{398, "Beldum", []uint{40, 55, 80, 35, 60, 30}, 255, 5, 29, 29, 8, 13},
+	{399, "Metang", []uint{60, 75, 100, 55, 80, 50}, 255, 5, 29, 29, 8, 13},
+	{400, "Metagross", []uint{80, 135, 130, 95, 90, 70}, 255, 5, 29, 29, 8, 13},
+	{401, "Regirock", []uint{80, 100, 200, 50, 100, 50}, 255, 5, 29, 29, 5, 5},
+	{402, "Regice", []uint{80, 50, 100, 100, 200, 50}, 255, 5, 29, 29, 14, 14},
+	{403, "Registeel", []uint{80, 75, 150, 75, 150, 50}, 255, 5, 29, 29, 8, 8},
+	{404, "Latias", []uint{80, 80, 90, 110, 130, 110}, 254, 5, 26, 26, 15, 13},
+	{405, "Latios", []uint{80, 90, 80, 130, 110, 110}, 0, 5, 26, 26, 15, 13},
+	{406, "Kyogre", []uint{100, 100, 90, 150, 140, 90}, 255, 5, 2, 2, 10, 10},
+	{407, "Groudon", []uint{100, 150, 140, 100, 90, 90}, 255, 5, 70, 70, 4, 4},
+	{408, "Rayquaza", []uint{105, 150, 90, 150, 90, 95}, 255, 5, 76, 76, 15, 2},
 	{409, "Jirachi", []uint{100, 100, 100, 100, 100, 100}, 255, 5, 32, 32, 8, 13},
-	{410, "Deoxys", []uint{50, 20, 150, 180, 180, 20}, 255, 5, 46, 46, 13, 13},
-	{411, "Chimecho", []uint{65, 70, 65, 95, 95, 80}, 127, 4, 26, 26, 13, 13},
+	{410, "Deoxys", []uint{50, 180, 20, 180, 20, 150}, 255, 5, 46, 46, 13, 13},
+	{411, "Chimecho", []uint{65, 50, 70, 95, 80, 65}, 127, 4, 26, 26, 13, 13},
 	{412, "Egg", []uint{0, 0, 0, 0, 0, 0}, 0, 0, 0, 0, 0, 0},
 	{413, "Glitched!", []uint{0, 0, 0, 0, 0, 0}, 0, 0, 0, 10, 0, 0},
 }
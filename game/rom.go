@@ -0,0 +1,373 @@
+package game
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"postal/utils"
+	"strings"
+)
+
+// Points at a ROM to read the game tables from
+const ROMEnv = "POSTAL_ROM"
+
+const (
+	romHeaderTitle   = 0xA0
+	romHeaderCode    = 0xAC
+	romHeaderVersion = 0xBC
+	romHeaderSize    = 0xC0
+
+	speciesNameSize = 11
+	moveNameSize    = 13
+	abilityNameSize = 13
+	itemSize        = 44
+	itemNameSize    = 14
+	baseStatsSize   = 28
+	moveDataSize    = 12
+
+	// Growth rate tables hold a word for every level from 0 to 100
+	expTableLevels = 101
+	expTableSize   = expTableLevels * 4
+
+	// ???, the type between Steel and Fire only Curse has
+	romMysteryType = 9
+)
+
+// ROMTables are the file offsets of the tables the loader reads
+type ROMTables struct {
+	SpeciesNames int
+	MoveNames    int
+	AbilityNames int
+	Items        int
+	BaseStats    int
+	Moves        int
+
+	// -1 when the growth rate tables couldn't be found, lookups then use
+	// the built in ones
+	Experience int
+}
+
+type ROM struct {
+	Title   string
+	Code    string
+	Version uint8
+	Tables  ROMTables
+
+	// Entries in the item table, ids past it read as item 0 like the
+	// game's own lookups do
+	ItemCount int
+
+	data []byte
+}
+
+type romVersion struct {
+	code    string
+	version uint8
+}
+
+// Where vanilla games keep their tables. Anything else, hacks included,
+// is found by searching for the first entries instead. The growth rate
+// tables are always searched for
+var knownROMTables = map[romVersion]ROMTables{
+	{"BPRE", 0}: {0x245EE0, 0x247094, 0x24FC4D, 0x3DB028, 0x254784, 0x250C04, 0},
+	{"BPEE", 0}: {0x3185C8, 0x31977C, 0x31B6DB, 0x5839A0, 0x3203CC, 0x31C898, 0},
+}
+
+// The first real entry of each table. Entry 0 is a placeholder in all
+// of them so the match sits one entry in
+var (
+	bulbasaurStats = []byte{45, 49, 49, 45, 65, 65, 12, 3}
+	poundData      = []byte{0x00, 40, 0x00, 100, 35, 0x00}
+	karateChopData = []byte{0x2B, 50, 0x01, 100, 25}
+
+	// Medium Fast is the first growth rate table, matched from level 2
+	// so the level 0 and 1 entries don't matter. Erratic comes straight
+	// after it and needs 15 for level 2
+	mediumFastExp = []byte{8, 0, 0, 0, 27, 0, 0, 0, 64, 0, 0, 0, 125, 0, 0, 0, 216, 0, 0, 0}
+)
+
+var rom *ROM
+
+// SetROM makes the lookups read from r, nil goes back to the built in
+// tables
+func SetROM(r *ROM) {
+	rom = r
+}
+
+func CurrentROM() *ROM {
+	return rom
+}
+
+func encodeName(s string) []byte {
+	b, _ := utils.EncodeText(s+"\\", len(s)+1, utils.LangEnglish)
+	return b
+}
+
+func LoadROM(p string) (*ROM, error) {
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+	return ParseROM(data)
+}
+
+func ParseROM(data []byte) (*ROM, error) {
+	if len(data) < romHeaderSize {
+		return nil, utils.ErrROMNotGen3
+	}
+
+	r := ROM{
+		Title:   strings.TrimRight(string(data[romHeaderTitle:romHeaderCode]), "\x00"),
+		Code:    string(data[romHeaderCode : romHeaderCode+4]),
+		Version: data[romHeaderVersion],
+		data:    data,
+	}
+
+	known := knownROMTables[romVersion{r.Code, r.Version}]
+
+	tables := []struct {
+		name  string
+		dst   *int
+		known int
+		sig   []byte
+		at    int
+		check func(off int) bool
+
+		// Tables the loader can do without are -1 when they're missing
+		optional bool
+	}{
+		{"species names", &r.Tables.SpeciesNames, known.SpeciesNames, encodeName("BULBASAUR"), speciesNameSize, nil, false},
+		{"move names", &r.Tables.MoveNames, known.MoveNames, encodeName("POUND"), moveNameSize, nil, false},
+		{"ability names", &r.Tables.AbilityNames, known.AbilityNames, encodeName("STENCH"), abilityNameSize, nil, false},
+		{"items", &r.Tables.Items, known.Items, encodeName("MASTER BALL"), itemSize, func(off int) bool {
+			return r.u16(off+itemSize+itemNameSize) == 1
+		}, false},
+		{"base stats", &r.Tables.BaseStats, known.BaseStats, bulbasaurStats, baseStatsSize, nil, false},
+		{"moves", &r.Tables.Moves, known.Moves, poundData, moveDataSize, func(off int) bool {
+			return r.has(off+2*moveDataSize, karateChopData)
+		}, false},
+		{"experience", &r.Tables.Experience, known.Experience, mediumFastExp, 2 * 4, func(off int) bool {
+			return r.u32(off+expTableSize+2*4) == 15
+		}, true},
+	}
+
+	for _, t := range tables {
+		valid := func(off int) bool {
+			return off >= 0 && r.has(off+t.at, t.sig) && (t.check == nil || t.check(off))
+		}
+
+		if t.known != 0 && valid(t.known) {
+			*t.dst = t.known
+			continue
+		}
+
+		off := r.search(t.sig, t.at, valid)
+		if off < 0 && !t.optional {
+			return nil, fmt.Errorf("%w: %s", utils.ErrROMTableNotFound, t.name)
+		}
+		*t.dst = off
+	}
+
+	for r.validItem(r.ItemCount) {
+		r.ItemCount++
+	}
+
+	return &r, nil
+}
+
+func (r *ROM) has(off int, b []byte) bool {
+	return off >= 0 && off+len(b) <= len(r.data) && bytes.Equal(r.data[off:off+len(b)], b)
+}
+
+// Offset of the first table whose entry at at matches sig and passes
+// valid, -1 when there's none
+func (r *ROM) search(sig []byte, at int, valid func(off int) bool) int {
+	for i := 0; ; {
+		j := bytes.Index(r.data[i:], sig)
+		if j < 0 {
+			return -1
+		}
+
+		if off := i + j - at; valid(off) {
+			return off
+		}
+		i += j + 1
+	}
+}
+
+func (r *ROM) u16(off int) uint16 {
+	if off < 0 || off+2 > len(r.data) {
+		return 0
+	}
+	return binary.LittleEndian.Uint16(r.data[off:])
+}
+
+func (r *ROM) u32(off int) uint32 {
+	if off < 0 || off+4 > len(r.data) {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(r.data[off:])
+}
+
+// Entry i of the table at off, the way the game indexes it without any
+// bounds check. Only reads past the end of the file fail
+func (r *ROM) entry(off, i, size int) ([]byte, bool) {
+	start := off + i*size
+	if start+size > len(r.data) {
+		return nil, false
+	}
+	return r.data[start : start+size], true
+}
+
+// Name field at the start of entry i, size bytes long in entries of
+// stride bytes
+func (r *ROM) name(off, i, stride, size int) (string, bool) {
+	b, ok := r.entry(off, i, stride)
+	if !ok {
+		return "", false
+	}
+	return utils.CleanText(utils.DecodeText(b[:size], utils.LangEnglish)), true
+}
+
+// Items end where the names stop being terminated or the pocket isn't
+// one of the five bag pockets
+func (r *ROM) validItem(i int) bool {
+	b, ok := r.entry(r.Tables.Items, i, itemSize)
+	return ok && bytes.IndexByte(b[:itemNameSize], 0xFF) >= 0 && b[26] <= 5
+}
+
+func (r *ROM) SpeciesName(i uint16) (string, bool) {
+	return r.name(r.Tables.SpeciesNames, int(i), speciesNameSize, speciesNameSize)
+}
+
+func (r *ROM) MoveName(i uint16) (string, bool) {
+	return r.name(r.Tables.MoveNames, int(i), moveNameSize, moveNameSize)
+}
+
+func (r *ROM) AbilityName(i uint8) (string, bool) {
+	return r.name(r.Tables.AbilityNames, int(i), abilityNameSize, abilityNameSize)
+}
+
+func (r *ROM) ItemName(i uint16) (string, bool) {
+	if int(i) >= r.ItemCount {
+		i = 0
+	}
+	return r.name(r.Tables.Items, int(i), itemSize, itemNameSize)
+}
+
+// The built in tables leave out the ??? type so everything after it is
+// one lower
+func romType(t byte) int {
+	switch {
+	case t == romMysteryType:
+		return 0xFF
+	case t > romMysteryType:
+		return int(t) - 1
+	}
+	return int(t)
+}
+
+// Species reads the base stats entry for i. Base stats are in HP, Atk,
+// Def, SpA, SpD, Spe order
+func (r *ROM) Species(i uint16) (SpeciesData, bool) {
+	b, ok := r.entry(r.Tables.BaseStats, int(i), baseStatsSize)
+	if !ok {
+		return SpeciesData{}, false
+	}
+
+	name, _ := r.SpeciesName(i)
+	d := SpeciesData{
+		Name:        name,
+		BaseStats:   []uint{uint(b[0]), uint(b[1]), uint(b[2]), uint(b[4]), uint(b[5]), uint(b[3])},
+		GenderRatio: int(b[16]),
+		ExpRate:     uint(b[19]),
+		Ab0:         int(b[22]),
+		Ab1:         int(b[23]),
+		Type1:       romType(b[6]),
+		Type2:       romType(b[7]),
+	}
+
+	if int(i) < len(SpeciesDataList) {
+		d.Dex = SpeciesDataList[i].Dex
+	}
+	return d, true
+}
+
+func (r *ROM) Move(i uint16) (MoveEntry, bool) {
+	b, ok := r.entry(r.Tables.Moves, int(i), moveDataSize)
+	if !ok {
+		return MoveEntry{}, false
+	}
+
+	name, _ := r.MoveName(i)
+	return MoveEntry{Index: int(i), Name: name, PP: uint(b[4])}, true
+}
+
+// Experience is the least experience a mon on growth rate rate needs for
+// level. Rates past the six tables read on into whatever follows them,
+// the same as the game
+func (r *ROM) Experience(rate uint, level int) (uint32, bool) {
+	if r.Tables.Experience < 0 {
+		return 0, false
+	}
+
+	b, ok := r.entry(r.Tables.Experience, int(rate)*expTableLevels+level, 4)
+	if !ok {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint32(b), true
+}
+
+// SpeciesInfo is species i out of the ROM when one is loaded, otherwise
+// out of the built in table. Glitch species only resolve with a ROM
+func SpeciesInfo(i uint16) (SpeciesData, bool) {
+	if rom != nil {
+		return rom.Species(i)
+	}
+
+	if int(i) < len(SpeciesDataList) {
+		return SpeciesDataList[i], true
+	}
+	return SpeciesData{}, false
+}
+
+func SpeciesName(i uint16) (string, bool) {
+	if rom != nil {
+		return rom.SpeciesName(i)
+	}
+
+	if int(i) < len(SpeciesDataList) {
+		return SpeciesDataList[i].Name, true
+	}
+	return "", false
+}
+
+func MoveName(i uint16) (string, bool) {
+	if rom != nil {
+		return rom.MoveName(i)
+	}
+
+	if int(i) < len(MovesList) {
+		return MovesList[i], true
+	}
+	return "", false
+}
+
+func ItemName(i uint16) (string, bool) {
+	if rom != nil {
+		return rom.ItemName(i)
+	}
+
+	n, ok := Items[i]
+	return n, ok
+}
+
+func AbilityName(i uint8) (string, bool) {
+	if rom != nil {
+		return rom.AbilityName(i)
+	}
+
+	n, ok := Abilities[i]
+	return n, ok
+}
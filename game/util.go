@@ -1,9 +1,9 @@
 package game
 
 import (
-	"fmt"
 	"maps"
 	"postal/utils"
+	"slices"
 )
 
 var (
@@ -42,16 +42,31 @@ func combineU16Split(hi, lo uint16) uint32 {
 	return uint32(hi)<<16 | uint32(lo)
 }
 
-func SearchXKEYSpecies(s uint16) {
+// MailWords lists every word value the mail can be written with. The
+// second set of Pokemon and both move categories unlock after the E4
+func MailWords(postE4 bool) []uint16 {
 	words := mergePreE4WordMap()
-	for i, v1 := range words {
-		for j, v2 := range words {
+	if postE4 {
+		words = MergedWordMap
+	}
+
+	return slices.Sorted(maps.Keys(words))
+}
+
+// SearchXKEYSpecies finds every pre E4 word pair that XORs to s
+func SearchXKEYSpecies(s uint16) [][2]uint16 {
+	var pairs [][2]uint16
+
+	words := MailWords(false)
+	for _, i := range words {
+		for _, j := range words {
 			if i^j == s {
-				o := fmt.Sprintf("%s - %s", v1, v2)
-				fmt.Println(o)
+				pairs = append(pairs, [2]uint16{i, j})
 			}
 		}
 	}
+
+	return pairs
 }
 
 func reversedU16ValueMap(m map[uint16]string) map[string]uint16 {
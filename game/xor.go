@@ -0,0 +1,133 @@
+package game
+
+import (
+	"iter"
+	"slices"
+	"sync"
+)
+
+// XORIndex lists every ordered word pair by the value the two words XOR
+// to. A mail key half is always one of these values, PIDHI ^ SID for the
+// high half and PIDLO ^ TID for the low one
+type XORIndex struct {
+	Words  []uint16
+	Values []uint16
+	pairs  [0x10000][][2]uint16
+
+	// Which PIDHI % 3 and PIDLO % 24 show up as the first word for each
+	// value. That's all the substruct order depends on
+	hiMods [0x10000]uint8
+	loMods [0x10000]uint32
+}
+
+var xorIndexes [2]*XORIndex
+var xorIndexOnce [2]sync.Once
+
+// GetXORIndex builds the index for the word set on first use
+func GetXORIndex(postE4 bool) *XORIndex {
+	i := 0
+	if postE4 {
+		i = 1
+	}
+
+	xorIndexOnce[i].Do(func() {
+		xorIndexes[i] = NewXORIndex(MailWords(postE4))
+	})
+	return xorIndexes[i]
+}
+
+func NewXORIndex(words []uint16) *XORIndex {
+	x := XORIndex{Words: words}
+
+	for _, a := range words {
+		for _, b := range words {
+			v := a ^ b
+			if x.pairs[v] == nil {
+				x.Values = append(x.Values, v)
+			}
+
+			x.pairs[v] = append(x.pairs[v], [2]uint16{a, b})
+			x.hiMods[v] |= 1 << (a % 3)
+			x.loMods[v] |= 1 << (a % 24)
+		}
+	}
+
+	slices.Sort(x.Values)
+	return &x
+}
+
+// Pairs gives the word pairs that XOR to v, the first word of each pair
+// is the PID half
+func (x *XORIndex) Pairs(v uint16) [][2]uint16 {
+	return x.pairs[v]
+}
+
+func (x *XORIndex) Has(v uint16) bool {
+	return x.pairs[v] != nil
+}
+
+// orderOf is the substruct order of PIDHI<<16 | PIDLO from the halves'
+// remainders, since 0x10000 % 24 is 16
+func orderOf(hiMod, loMod uint16) uint16 {
+	return (16*hiMod + loMod) % 24
+}
+
+// CanReachOrder reports whether some PIDHI from the hi pairs and PIDLO
+// from the lo pairs make a PID with the given substruct order
+func (x *XORIndex) CanReachOrder(hi, lo uint16, order uint16) bool {
+	for h := range uint16(3) {
+		if x.hiMods[hi]&(1<<h) == 0 {
+			continue
+		}
+
+		for l := range uint16(24) {
+			if x.loMods[lo]&(1<<l) != 0 && orderOf(h, l) == order {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Tuples walks every PIDHI, PIDLO, SID, TID word set for the key halves
+// whose PID lands on the order
+func (x *XORIndex) Tuples(hi, lo uint16, order uint16) iter.Seq[[4]uint16] {
+	return func(yield func([4]uint16) bool) {
+		for _, h := range x.pairs[hi] {
+			for _, l := range x.pairs[lo] {
+				if orderOf(h[0]%3, l[0]%24) != order {
+					continue
+				}
+
+				if !yield([4]uint16{h[0], l[0], h[1], l[1]}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TupleCount is how many sets Tuples would walk without walking them
+func (x *XORIndex) TupleCount(hi, lo uint16, order uint16) int {
+	var his [3]int
+	var los [24]int
+
+	for _, h := range x.pairs[hi] {
+		his[h[0]%3]++
+	}
+	for _, l := range x.pairs[lo] {
+		los[l[0]%24]++
+	}
+
+	n := 0
+	for h := range uint16(3) {
+		for l := range uint16(24) {
+			if orderOf(h, l) == order {
+				n += his[h] * los[l]
+			}
+		}
+	}
+
+	return n
+}
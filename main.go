@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"postal/game"
 	"postal/pokemon"
 	"postal/save"
 	"postal/tui"
@@ -17,6 +18,8 @@ const (
 	pk3 = iota
 	ek3
 	sav
+	jsonDoc
+	yamlDoc
 	None
 )
 
@@ -28,16 +31,45 @@ func determineExtension(s string) int {
 		return ek3
 	case ".sav", ".SAV":
 		return sav
+	case ".json":
+		return jsonDoc
+	case ".yaml", ".yml":
+		return yamlDoc
 	default:
 		return None
 	}
 }
 
 func main() {
-	clearScreen()
-
 	args := os.Args[1:]
 
+	// Names and stats come out of the ROM for every command and the TUI
+	if p := os.Getenv(game.ROMEnv); p != "" {
+		if r, err := game.LoadROM(p); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: %s: %v\n", game.ROMEnv, err)
+		} else {
+			game.SetROM(r)
+		}
+	}
+
+	// Subcommands run headless so they can be scripted
+	if len(args) > 0 {
+		if c := findCommand(args[0]); c != nil {
+			if err := c.run(args[1:], os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "err: %v\n", err)
+				os.Exit(1)
+			}
+			return
+		}
+
+		if args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+			printUsage(os.Stdout)
+			return
+		}
+	}
+
+	clearScreen()
+
 	if len(args) == 1 {
 		f := args[0]
 		ext := determineExtension(f)
@@ -66,9 +98,19 @@ func main() {
 				}
 			}
 
+		case jsonDoc, yamlDoc:
+			if pks, err := loadMon(f, &slotFlags{}); err != nil {
+				fmt.Printf("err: %v\n", err)
+				os.Exit(1)
+			} else {
+				p := tea.NewProgram(tui.InitMainEditor(pks))
+				if _, err := p.Run(); err != nil {
+					os.Exit(1)
+				}
+			}
+
 		case sav:
-			sav := getDataFromPath(f)
-			p := tea.NewProgram(tui.InitMainBoxData(sav))
+			p := tea.NewProgram(tui.InitMainSaveData(f))
 			if _, err := p.Run(); err != nil {
 				os.Exit(1)
 			}
@@ -88,17 +130,9 @@ func main() {
 	}
 }
 
-func getDataFromPath(p string) save.RawBoxDataTotal {
-	sav := save.GenerateRawSaveData(p)
-	block := sav.GenerateSaveBlock()
-
-	return block.GetRawBoxData()
-}
-
 func getPK3FromFile(p string) (pokemon.PStructure, error) {
 	pk, err := save.GetRawMonDataFromFile(p)
 	if err != nil {
-		fmt.Printf("err: %v\n", err)
 		return pokemon.PStructure{}, err
 	} else {
 		return pokemon.GeneratePokemonFromRawData(pk, false, true), nil
@@ -108,7 +142,6 @@ func getPK3FromFile(p string) (pokemon.PStructure, error) {
 func getEK3FromFile(p string) (pokemon.PStructure, error) {
 	pk, err := save.GetRawMonDataFromFile(p)
 	if err != nil {
-		fmt.Printf("err: %v\n", err)
 		return pokemon.PStructure{}, err
 	} else {
 		return pokemon.GeneratePokemonFromRawData(pk, false, false), nil
@@ -0,0 +1,228 @@
+package pokemon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	vals "postal/game"
+	"postal/utils"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ChainMail = iota
+	ChainMove
+)
+
+var ChainStepNames = []string{"mail", "move"}
+
+// ChainStep is one thing done to the mon in game. Mail steps overwrite
+// the PID and OTID with Words, move steps are a box move or anything
+// else that makes the game decrypt the mon and run its checksum check
+type ChainStep struct {
+	Kind  int
+	Words [4]uint16
+}
+
+type ChainState struct {
+	Mon   PStructure
+	Check BadEggCheck
+}
+
+// MailChain replays its steps on the encrypted bytes of Base, so the
+// checksum is only looked at when a move step makes the game read it
+type MailChain struct {
+	Base  PStructure
+	Steps []ChainStep
+}
+
+func NewMailStep(PID, OTID uint32) ChainStep {
+	return ChainStep{
+		Kind: ChainMail,
+		Words: [4]uint16{
+			uint16(PID >> 16), uint16(PID),
+			uint16(OTID >> 16), uint16(OTID),
+		},
+	}
+}
+
+func (s ChainStep) PID() uint32 {
+	return CombineU16Split(s.Words[MailPIDHI], s.Words[MailPIDLO])
+}
+
+func (s ChainStep) OTID() uint32 {
+	return CombineU16Split(s.Words[MailSID], s.Words[MailTID])
+}
+
+func (c *MailChain) Add(s ChainStep) {
+	c.Steps = append(c.Steps, s)
+}
+
+func (c *MailChain) Set(i int, s ChainStep) error {
+	if i < 0 || i >= len(c.Steps) {
+		return utils.ErrOutOfRange
+	}
+
+	c.Steps[i] = s
+	return nil
+}
+
+func (c *MailChain) Remove(i int) error {
+	if i < 0 || i >= len(c.Steps) {
+		return utils.ErrOutOfRange
+	}
+
+	c.Steps = slices.Delete(c.Steps, i, i+1)
+	return nil
+}
+
+// Encrypts the mon without fixing up its checksum, the game never
+// rewrites it after flagging a Bad Egg
+func (p *PStructure) toEK3KeepChecksum() ([]byte, error) {
+	ek, err := p.ToEK3()
+	if err != nil {
+		return nil, err
+	}
+
+	binary.LittleEndian.PutUint16(ek[0x1C:0x1E], p.Checksum)
+	return ek, nil
+}
+
+// Run gives the mon after every step
+func (c *MailChain) Run() ([]ChainState, error) {
+	ek, err := c.Base.ToEK3()
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]ChainState, len(c.Steps))
+
+	for i, s := range c.Steps {
+		switch s.Kind {
+		case ChainMail:
+			binary.LittleEndian.PutUint32(ek[0:4], s.PID())
+			binary.LittleEndian.PutUint32(ek[4:8], s.OTID())
+
+		case ChainMove:
+			pk := GeneratePokemonFromRawData(ek, false, false)
+			pk.SimulateDecrypt()
+			if ek, err = pk.toEK3KeepChecksum(); err != nil {
+				return nil, err
+			}
+		}
+
+		pk := GeneratePokemonFromRawData(ek, false, false)
+		states[i] = ChainState{Mon: pk, Check: pk.CheckBadEgg()}
+	}
+
+	return states, nil
+}
+
+// Result is the last state as the game will see it when it next reads it
+func (c *MailChain) Result() (ChainState, error) {
+	if len(c.Steps) == 0 {
+		return ChainState{Mon: c.Base, Check: c.Base.CheckBadEgg()}, nil
+	}
+
+	states, err := c.Run()
+	if err != nil {
+		return ChainState{}, err
+	}
+
+	r := states[len(c.Steps)-1]
+	r.Check = r.Mon.SimulateDecrypt()
+	return r, nil
+}
+
+type (
+	// MailChainDocument is the recipe for a chain. The base mon and the
+	// words are what matter, the results are only written for reference
+	MailChainDocument struct {
+		Version int                 `json:"version" yaml:"version"`
+		Base    MonDocument         `json:"base" yaml:"base"`
+		Steps   []ChainStepDocument `json:"steps" yaml:"steps"`
+	}
+
+	ChainStepDocument struct {
+		Kind   string         `json:"kind" yaml:"kind"`
+		Words  []DocValue     `json:"words,omitempty" yaml:"words,omitempty"`
+		Result ChainResultDoc `json:"result" yaml:"result"`
+	}
+
+	ChainResultDoc struct {
+		PID     string   `json:"pid" yaml:"pid"`
+		OTID    string   `json:"otid" yaml:"otid"`
+		Species DocValue `json:"species" yaml:"species"`
+		BadEgg  bool     `json:"bad_egg" yaml:"bad_egg"`
+	}
+)
+
+func (c *MailChain) ToDocument() (MailChainDocument, error) {
+	pk, err := c.Base.ToPK3()
+	if err != nil {
+		return MailChainDocument{}, err
+	}
+
+	base, err := NewMonDocument(pk)
+	if err != nil {
+		return MailChainDocument{}, err
+	}
+
+	states, err := c.Run()
+	if err != nil {
+		return MailChainDocument{}, err
+	}
+
+	d := MailChainDocument{
+		Version: MonDocumentVersion,
+		Base:    base,
+		Steps:   make([]ChainStepDocument, len(c.Steps)),
+	}
+
+	for i, st := range states {
+		s := c.Steps[i]
+		sp, name := st.Mon.GetSpecies()
+
+		step := ChainStepDocument{
+			Kind: ChainStepNames[s.Kind],
+			Result: ChainResultDoc{
+				PID:     fmt.Sprintf("%08X", st.Mon.PID),
+				OTID:    fmt.Sprintf("%08X", st.Mon.OTID),
+				Species: DocValue{uint16(sp), name},
+				BadEgg:  st.Check.WillBeBadEgg(),
+			},
+		}
+
+		if s.Kind == ChainMail {
+			for _, w := range s.Words {
+				step.Words = append(step.Words, DocValue{w, vals.MergedWordMap[w]})
+			}
+		}
+
+		d.Steps[i] = step
+	}
+
+	return d, nil
+}
+
+func (d *MailChainDocument) ToJSON() ([]byte, error) {
+	b, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+func (d *MailChainDocument) ToYAML() ([]byte, error) {
+	b := new(bytes.Buffer)
+	e := yaml.NewEncoder(b)
+	e.SetIndent(2)
+
+	if err := e.Encode(d); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), e.Close()
+}
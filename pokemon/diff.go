@@ -0,0 +1,217 @@
+package pokemon
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Where a field lives in the structure, substructs by their GAEM index
+const (
+	DiffHeader = -1
+	DiffParty  = 4
+)
+
+// FieldChange is one field that differs between two mons. Names are only
+// set for fields that decode to something, like species or moves
+type FieldChange struct {
+	Field   string
+	Block   int
+	Old     string
+	New     string
+	OldName string
+	NewName string
+}
+
+// ByteChange is one byte that differs between two raw structures
+type ByteChange struct {
+	Offset int
+	Old    byte
+	New    byte
+}
+
+// MonDiff holds every field and every EK3 byte that differs going from
+// one mon to another
+type MonDiff struct {
+	Fields []FieldChange
+	Bytes  []ByteChange
+}
+
+type diffField struct {
+	name   string
+	block  int
+	value  func(p *PStructure) string
+	decode func(p *PStructure) string
+}
+
+func dec[T uint8 | uint16 | uint32](f func(p *PStructure) T) func(p *PStructure) string {
+	return func(p *PStructure) string {
+		return strconv.FormatUint(uint64(f(p)), 10)
+	}
+}
+
+func hexValue[T uint8 | uint16 | uint32](f func(p *PStructure) T) func(p *PStructure) string {
+	return func(p *PStructure) string {
+		return fmt.Sprintf("0x%X", f(p))
+	}
+}
+
+func named[T uint8 | uint16 | uint32](f func(p *PStructure) (T, string)) func(p *PStructure) string {
+	return func(p *PStructure) string {
+		_, s := f(p)
+		return s
+	}
+}
+
+func makeDiffFields() []diffField {
+	fields := []diffField{
+		{"PID", DiffHeader, hexValue(func(p *PStructure) uint32 { return p.PID }), func(p *PStructure) string {
+			_, nature := p.GetNature()
+			_, order := p.GetMonSubStructOrderString()
+			return nature + " " + order
+		}},
+		{"OTID", DiffHeader, hexValue(func(p *PStructure) uint32 { return p.OTID }), func(p *PStructure) string {
+			tid, sid := p.GetTIDSIDComboPair()
+			return fmt.Sprintf("TID %05d SID %05d", tid, sid)
+		}},
+		{"Nickname", DiffHeader, func(p *PStructure) string { return p.NickName }, nil},
+		{"Language", DiffHeader, dec(func(p *PStructure) uint8 { return p.Lang }), named((*PStructure).GetLanguage)},
+		{"Flags", DiffHeader, hexValue(func(p *PStructure) uint8 { return p.Flags }), nil},
+		{"OT Name", DiffHeader, func(p *PStructure) string { return p.OTName }, nil},
+		{"Markings", DiffHeader, hexValue(func(p *PStructure) uint8 { return p.Markings }), nil},
+		{"Checksum", DiffHeader, hexValue(func(p *PStructure) uint16 { return p.Checksum }), nil},
+		{"Padding", DiffHeader, hexValue(func(p *PStructure) uint16 { return p.filler }), nil},
+
+		{"Species", 0, dec(func(p *PStructure) uint16 { return p.Sub0.Species }), named((*PStructure).GetSpecies)},
+		{"Held Item", 0, dec(func(p *PStructure) uint16 { return p.Sub0.HeldItem }), named((*PStructure).GetHeldItem)},
+		{"Experience", 0, dec(func(p *PStructure) uint32 { return p.Sub0.Experience }), nil},
+		{"PP Bonuses", 0, hexValue(func(p *PStructure) uint8 { return p.Sub0.PPBonuses }), nil},
+		{"Friendship", 0, dec(func(p *PStructure) uint8 { return p.Sub0.FriendShip }), nil},
+		{"Growth Padding", 0, hexValue(func(p *PStructure) uint16 { return p.Sub0.filler }), nil},
+	}
+
+	for i := range 4 {
+		fields = append(fields, diffField{
+			fmt.Sprintf("Move %d", i+1), 1,
+			dec(func(p *PStructure) uint16 { return p.Sub1.Moves[i] }),
+			func(p *PStructure) string { return p.GetMoveStrings()[i] },
+		})
+	}
+
+	for i := range 4 {
+		fields = append(fields, diffField{
+			fmt.Sprintf("PP %d", i+1), 1,
+			dec(func(p *PStructure) uint8 { return p.Sub1.PP[i] }), nil,
+		})
+	}
+
+	evs := []struct {
+		name string
+		f    func(p *PStructure) uint8
+	}{
+		{"HP EV", func(p *PStructure) uint8 { return p.Sub2.HpEV }},
+		{"Atk EV", func(p *PStructure) uint8 { return p.Sub2.AtkEV }},
+		{"Def EV", func(p *PStructure) uint8 { return p.Sub2.DefEV }},
+		{"Spe EV", func(p *PStructure) uint8 { return p.Sub2.SpeEV }},
+		{"SpA EV", func(p *PStructure) uint8 { return p.Sub2.SpAtkEV }},
+		{"SpD EV", func(p *PStructure) uint8 { return p.Sub2.SpDefEV }},
+		{"Coolness", func(p *PStructure) uint8 { return p.Sub2.Cool }},
+		{"Beauty", func(p *PStructure) uint8 { return p.Sub2.Beauty }},
+		{"Cuteness", func(p *PStructure) uint8 { return p.Sub2.Cute }},
+		{"Smartness", func(p *PStructure) uint8 { return p.Sub2.Smart }},
+		{"Toughness", func(p *PStructure) uint8 { return p.Sub2.Tough }},
+		{"Feel", func(p *PStructure) uint8 { return p.Sub2.Feel }},
+	}
+
+	for _, v := range evs {
+		fields = append(fields, diffField{v.name, 2, dec(v.f), nil})
+	}
+
+	rest := []diffField{
+		{"Pokerus", 3, hexValue(func(p *PStructure) uint8 { return p.Sub3.Pokerus }), nil},
+		{"Met Location", 3, dec(func(p *PStructure) uint8 { return p.Sub3.MetLocation }), named((*PStructure).GetMetLocation)},
+		{"Met Level", 3, dec(func(p *PStructure) uint8 { return p.Sub3.MetLevel }), nil},
+		{"Origin Game", 3, dec(func(p *PStructure) uint8 { return p.Sub3.MetGame }), named((*PStructure).GetOriginGame)},
+		{"Ball", 3, dec(func(p *PStructure) uint8 { return p.Sub3.Ball }), named((*PStructure).GetBall)},
+		{"OT Gender", 3, dec(func(p *PStructure) uint8 { return p.Sub3.OTGender }), func(p *PStructure) string {
+			_, g := p.GetOTGender()
+			return g
+		}},
+		{"HP IV", 3, dec(func(p *PStructure) uint8 { return p.Sub3.HpIV }), nil},
+		{"Atk IV", 3, dec(func(p *PStructure) uint8 { return p.Sub3.AtkIV }), nil},
+		{"Def IV", 3, dec(func(p *PStructure) uint8 { return p.Sub3.DefIV }), nil},
+		{"Spe IV", 3, dec(func(p *PStructure) uint8 { return p.Sub3.SpeIV }), nil},
+		{"SpA IV", 3, dec(func(p *PStructure) uint8 { return p.Sub3.SpAtkIV }), nil},
+		{"SpD IV", 3, dec(func(p *PStructure) uint8 { return p.Sub3.SpDIV }), nil},
+		{"Is Egg", 3, dec(func(p *PStructure) uint8 { return p.Sub3.IsEgg }), nil},
+		{"Ability", 3, dec(func(p *PStructure) uint8 { return p.Sub3.AbilityNum }), named((*PStructure).GetAbility)},
+		{"Ribbons", 3, hexValue((*PStructure).CalculateRibbonValue), nil},
+
+		{"Status", DiffParty, hexValue(func(p *PStructure) uint32 { return p.Status }), named((*PStructure).GetStatusCondition)},
+		{"Level", DiffParty, dec(func(p *PStructure) uint8 { return p.Level }), nil},
+		{"Mail ID", DiffParty, dec(func(p *PStructure) uint8 { return p.MailID }), nil},
+		{"Current HP", DiffParty, dec(func(p *PStructure) uint16 { return p.CurHP }), nil},
+		{"Max HP", DiffParty, dec(func(p *PStructure) uint16 { return p.TotalHP }), nil},
+		{"Atk", DiffParty, dec(func(p *PStructure) uint16 { return p.Atk }), nil},
+		{"Def", DiffParty, dec(func(p *PStructure) uint16 { return p.Def }), nil},
+		{"Spe", DiffParty, dec(func(p *PStructure) uint16 { return p.Spe }), nil},
+		{"Spa", DiffParty, dec(func(p *PStructure) uint16 { return p.Spa }), nil},
+		{"Spd", DiffParty, dec(func(p *PStructure) uint16 { return p.Spd }), nil},
+	}
+
+	return append(fields, rest...)
+}
+
+var diffFields = makeDiffFields()
+
+// DiffBytes lists every offset where two raw structures differ, bytes
+// past the end of the shorter one count as zero
+func DiffBytes(a, b []byte) []ByteChange {
+	var out []ByteChange
+
+	for i := range max(len(a), len(b)) {
+		var x, y byte
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+
+		if x != y {
+			out = append(out, ByteChange{Offset: i, Old: x, New: y})
+		}
+	}
+
+	return out
+}
+
+// DiffMons compares every field of from and to, along with the EK3 bytes
+// each would be written as with their stored checksums
+func DiffMons(from, to *PStructure) MonDiff {
+	var d MonDiff
+
+	for _, f := range diffFields {
+		o, n := f.value(from), f.value(to)
+		if o == n {
+			continue
+		}
+
+		c := FieldChange{Field: f.name, Block: f.block, Old: o, New: n}
+		if f.decode != nil {
+			c.OldName, c.NewName = f.decode(from), f.decode(to)
+		}
+		d.Fields = append(d.Fields, c)
+	}
+
+	// A mon whose names don't encode has no bytes to compare
+	old, errOld := from.toEK3KeepChecksum()
+	cur, errCur := to.toEK3KeepChecksum()
+	if errOld == nil && errCur == nil {
+		d.Bytes = DiffBytes(old, cur)
+	}
+	return d
+}
+
+func (d MonDiff) IsEmpty() bool {
+	return len(d.Fields) == 0 && len(d.Bytes) == 0
+}
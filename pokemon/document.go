@@ -0,0 +1,373 @@
+package pokemon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"postal/utils"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Bump when fields are renamed or their meaning changes
+const MonDocumentVersion = 1
+
+// MonDocument is the readable interchange format for a mon. Numeric
+// values are always authoritative, names next to them are only there
+// for whoever is reading the file and are ignored when parsing
+type (
+	MonDocument struct {
+		Version       int          `json:"version" yaml:"version"`
+		PID           uint32       `json:"pid" yaml:"pid"`
+		OTID          uint32       `json:"otid" yaml:"otid"`
+		Nature        string       `json:"nature" yaml:"nature"`
+		Nickname      DocText      `json:"nickname" yaml:"nickname"`
+		OTName        DocText      `json:"ot_name" yaml:"ot_name"`
+		Language      uint8        `json:"language" yaml:"language"`
+		Flags         uint8        `json:"flags" yaml:"flags"`
+		Markings      uint8        `json:"markings" yaml:"markings"`
+		Checksum      uint16       `json:"checksum" yaml:"checksum"`
+		ChecksumValid bool         `json:"checksum_valid" yaml:"checksum_valid"`
+		Filler        uint16       `json:"filler" yaml:"filler"`
+		Growth        DocGrowth    `json:"growth" yaml:"growth"`
+		Moves         [4]DocMove   `json:"moves" yaml:"moves"`
+		EVs           DocStats     `json:"evs" yaml:"evs"`
+		Contest       DocContest   `json:"contest" yaml:"contest"`
+		Misc          DocMisc      `json:"misc" yaml:"misc"`
+		Party         DocPartyData `json:"party" yaml:"party"`
+	}
+
+	// Text is what the game shows, Raw is the exact bytes. Raw is only
+	// used while Text still matches it so edits to Text are picked up
+	DocText struct {
+		Text string `json:"text" yaml:"text"`
+		Raw  string `json:"raw" yaml:"raw"`
+	}
+
+	DocValue struct {
+		Value uint16 `json:"value" yaml:"value"`
+		Name  string `json:"name" yaml:"name"`
+	}
+
+	DocGrowth struct {
+		Species    DocValue `json:"species" yaml:"species"`
+		HeldItem   DocValue `json:"held_item" yaml:"held_item"`
+		Experience uint32   `json:"experience" yaml:"experience"`
+		PPBonuses  uint8    `json:"pp_bonuses" yaml:"pp_bonuses"`
+		Friendship uint8    `json:"friendship" yaml:"friendship"`
+		Filler     uint16   `json:"filler" yaml:"filler"`
+	}
+
+	DocMove struct {
+		Value uint16 `json:"value" yaml:"value"`
+		Name  string `json:"name" yaml:"name"`
+		PP    uint8  `json:"pp" yaml:"pp"`
+	}
+
+	DocStats struct {
+		HP    uint8 `json:"hp" yaml:"hp"`
+		Atk   uint8 `json:"atk" yaml:"atk"`
+		Def   uint8 `json:"def" yaml:"def"`
+		Spe   uint8 `json:"spe" yaml:"spe"`
+		SpAtk uint8 `json:"spa" yaml:"spa"`
+		SpDef uint8 `json:"spd" yaml:"spd"`
+	}
+
+	DocContest struct {
+		Cool   uint8 `json:"cool" yaml:"cool"`
+		Beauty uint8 `json:"beauty" yaml:"beauty"`
+		Cute   uint8 `json:"cute" yaml:"cute"`
+		Smart  uint8 `json:"smart" yaml:"smart"`
+		Tough  uint8 `json:"tough" yaml:"tough"`
+		Feel   uint8 `json:"feel" yaml:"feel"`
+	}
+
+	DocMisc struct {
+		Pokerus     uint8    `json:"pokerus" yaml:"pokerus"`
+		MetLocation DocValue `json:"met_location" yaml:"met_location"`
+		MetLevel    uint8    `json:"met_level" yaml:"met_level"`
+		OriginGame  DocValue `json:"origin_game" yaml:"origin_game"`
+		Ball        DocValue `json:"ball" yaml:"ball"`
+		OTGender    uint8    `json:"ot_gender" yaml:"ot_gender"`
+		IVs         DocStats `json:"ivs" yaml:"ivs"`
+		IsEgg       uint8    `json:"is_egg" yaml:"is_egg"`
+		Ability     DocValue `json:"ability" yaml:"ability"`
+		Ribbons     uint32   `json:"ribbons" yaml:"ribbons"`
+	}
+
+	DocPartyData struct {
+		Status  uint32 `json:"status" yaml:"status"`
+		Level   uint8  `json:"level" yaml:"level"`
+		MailID  uint8  `json:"mail_id" yaml:"mail_id"`
+		CurHP   uint16 `json:"cur_hp" yaml:"cur_hp"`
+		TotalHP uint16 `json:"total_hp" yaml:"total_hp"`
+		Atk     uint16 `json:"atk" yaml:"atk"`
+		Def     uint16 `json:"def" yaml:"def"`
+		Spe     uint16 `json:"spe" yaml:"spe"`
+		Spa     uint16 `json:"spa" yaml:"spa"`
+		Spd     uint16 `json:"spd" yaml:"spd"`
+	}
+)
+
+func newDocText(raw []byte, text string) DocText {
+	return DocText{
+		Text: text,
+		Raw:  strings.ToUpper(hex.EncodeToString(raw)),
+	}
+}
+
+// Returns the raw bytes when the text was left alone, nil otherwise
+func (t DocText) rawIfUnchanged(size int, lang uint8, decode func([]byte, uint8) string) ([]byte, error) {
+	raw, err := hex.DecodeString(t.Raw)
+	if err != nil || len(raw) != size {
+		return nil, fmt.Errorf("bad raw text %q", t.Raw)
+	}
+
+	if utils.CleanText(decode(raw, lang)) != t.Text {
+		return nil, nil
+	}
+
+	return raw, nil
+}
+
+// Puts back the terminator CleanText strips off when there's room
+func terminatedText(s string, size int, lang uint8) string {
+	if _, err := utils.EncodeText(s+"\\", size, lang); err == nil {
+		return s + "\\"
+	}
+	return s
+}
+
+// NewMonDocument builds a document from decrypted pk3 bytes. The bytes
+// are needed rather than a PStructure since names are stored lossily
+func NewMonDocument(pk []byte) (MonDocument, error) {
+	if len(pk) != 0x64 {
+		return MonDocument{}, utils.ErrPKIncorrectFileSize
+	}
+
+	p := GeneratePokemonFromRawData(pk, false, true)
+	nick := pk[0x8:0x12]
+	ot := pk[0x14:0x1B]
+
+	_, nature := p.GetNature()
+	_, species := p.GetSpecies()
+	_, item := p.GetHeldItem()
+	_, loc := p.GetMetLocation()
+	_, game := p.GetOriginGame()
+	_, ball := p.GetBall()
+	_, ability := p.GetAbility()
+	moves := p.GetMoveStrings()
+
+	d := MonDocument{
+		Version:       MonDocumentVersion,
+		PID:           p.PID,
+		OTID:          p.OTID,
+		Nature:        nature,
+		Nickname:      newDocText(nick, utils.CleanText(utils.GenerateNickNameString(nick, p.Lang))),
+		OTName:        newDocText(ot, utils.CleanText(utils.GenerateOTNameString(ot, p.Lang))),
+		Language:      p.Lang,
+		Flags:         p.Flags,
+		Markings:      p.Markings,
+		Checksum:      p.Checksum,
+		ChecksumValid: p.Checksum == generateChecksum(pk[0x20:0x50]),
+		Filler:        p.filler,
+		Growth: DocGrowth{
+			Species:    DocValue{p.Sub0.Species, species},
+			HeldItem:   DocValue{p.Sub0.HeldItem, item},
+			Experience: p.Sub0.Experience,
+			PPBonuses:  p.Sub0.PPBonuses,
+			Friendship: p.Sub0.FriendShip,
+			Filler:     p.Sub0.filler,
+		},
+		EVs: DocStats{
+			p.Sub2.HpEV, p.Sub2.AtkEV, p.Sub2.DefEV,
+			p.Sub2.SpeEV, p.Sub2.SpAtkEV, p.Sub2.SpDefEV,
+		},
+		Contest: DocContest{
+			p.Sub2.Cool, p.Sub2.Beauty, p.Sub2.Cute,
+			p.Sub2.Smart, p.Sub2.Tough, p.Sub2.Feel,
+		},
+		Misc: DocMisc{
+			Pokerus:     p.Sub3.Pokerus,
+			MetLocation: DocValue{uint16(p.Sub3.MetLocation), loc},
+			MetLevel:    p.Sub3.MetLevel,
+			OriginGame:  DocValue{uint16(p.Sub3.MetGame), game},
+			Ball:        DocValue{uint16(p.Sub3.Ball), ball},
+			OTGender:    p.Sub3.OTGender,
+			IVs: DocStats{
+				p.Sub3.HpIV, p.Sub3.AtkIV, p.Sub3.DefIV,
+				p.Sub3.SpeIV, p.Sub3.SpAtkIV, p.Sub3.SpDIV,
+			},
+			IsEgg:   p.Sub3.IsEgg,
+			Ability: DocValue{uint16(p.Sub3.AbilityNum), ability},
+			Ribbons: p.CalculateRibbonValue(),
+		},
+		Party: DocPartyData{
+			Status:  p.Status,
+			Level:   p.Level,
+			MailID:  p.MailID,
+			CurHP:   p.CurHP,
+			TotalHP: p.TotalHP,
+			Atk:     p.Atk,
+			Def:     p.Def,
+			Spe:     p.Spe,
+			Spa:     p.Spa,
+			Spd:     p.Spd,
+		},
+	}
+
+	for i := range d.Moves {
+		d.Moves[i] = DocMove{p.Sub1.Moves[i], moves[i], p.Sub1.PP[i]}
+	}
+
+	return d, nil
+}
+
+// ToPStructure rebuilds the mon from the numeric values. Names go
+// through the text encoder so use ToPK3 when the exact bytes matter
+func (d *MonDocument) ToPStructure() PStructure {
+	p := PStructure{
+		PID:      d.PID,
+		OTID:     d.OTID,
+		NickName: terminatedText(d.Nickname.Text, NickNameMaxLength, d.Language),
+		Lang:     d.Language,
+		Flags:    d.Flags,
+		OTName:   terminatedText(d.OTName.Text, TrainerNameMaxLength, d.Language),
+		Markings: d.Markings,
+		Checksum: d.Checksum,
+		filler:   d.Filler,
+		Sub0: SubStruct0{
+			Species:    d.Growth.Species.Value,
+			HeldItem:   d.Growth.HeldItem.Value,
+			Experience: d.Growth.Experience,
+			PPBonuses:  d.Growth.PPBonuses,
+			FriendShip: d.Growth.Friendship,
+			filler:     d.Growth.Filler,
+		},
+		Sub2: SubStruct2{
+			HpEV:    d.EVs.HP,
+			AtkEV:   d.EVs.Atk,
+			DefEV:   d.EVs.Def,
+			SpeEV:   d.EVs.Spe,
+			SpAtkEV: d.EVs.SpAtk,
+			SpDefEV: d.EVs.SpDef,
+			Cool:    d.Contest.Cool,
+			Beauty:  d.Contest.Beauty,
+			Cute:    d.Contest.Cute,
+			Smart:   d.Contest.Smart,
+			Tough:   d.Contest.Tough,
+			Feel:    d.Contest.Feel,
+		},
+		Sub3: SubStruct3{
+			Pokerus:     d.Misc.Pokerus,
+			MetLocation: uint8(d.Misc.MetLocation.Value),
+			MetLevel:    d.Misc.MetLevel,
+			MetGame:     uint8(d.Misc.OriginGame.Value),
+			Ball:        uint8(d.Misc.Ball.Value),
+			OTGender:    d.Misc.OTGender,
+			HpIV:        d.Misc.IVs.HP,
+			AtkIV:       d.Misc.IVs.Atk,
+			DefIV:       d.Misc.IVs.Def,
+			SpeIV:       d.Misc.IVs.Spe,
+			SpAtkIV:     d.Misc.IVs.SpAtk,
+			SpDIV:       d.Misc.IVs.SpDef,
+			IsEgg:       d.Misc.IsEgg,
+			AbilityNum:  uint8(d.Misc.Ability.Value),
+		},
+		Status:  d.Party.Status,
+		Level:   d.Party.Level,
+		MailID:  d.Party.MailID,
+		CurHP:   d.Party.CurHP,
+		TotalHP: d.Party.TotalHP,
+		Atk:     d.Party.Atk,
+		Def:     d.Party.Def,
+		Spe:     d.Party.Spe,
+		Spa:     d.Party.Spa,
+		Spd:     d.Party.Spd,
+	}
+
+	for i := range d.Moves {
+		p.Sub1.Moves[i] = d.Moves[i].Value
+		p.Sub1.PP[i] = d.Moves[i].PP
+	}
+
+	p.ReplaceRibbonByValue(d.Misc.Ribbons)
+	return p
+}
+
+// ToPK3 gives back the decrypted bytes. Untouched names and a checksum
+// that was already broken are copied over as is so nothing is lost
+func (d *MonDocument) ToPK3() ([]byte, error) {
+	if d.Version != MonDocumentVersion {
+		return nil, fmt.Errorf("unsupported mon document version %d", d.Version)
+	}
+
+	p := d.ToPStructure()
+
+	pk, err := p.ToPK3()
+	if err != nil {
+		return nil, err
+	}
+
+	nick, err := d.Nickname.rawIfUnchanged(NickNameMaxLength, d.Language, utils.GenerateNickNameString)
+	if err != nil {
+		return nil, err
+	}
+	if nick != nil {
+		copy(pk[0x8:0x12], nick)
+	}
+
+	ot, err := d.OTName.rawIfUnchanged(TrainerNameMaxLength, d.Language, utils.GenerateOTNameString)
+	if err != nil {
+		return nil, err
+	}
+	if ot != nil {
+		copy(pk[0x14:0x1B], ot)
+	}
+
+	if !d.ChecksumValid {
+		binary.LittleEndian.PutUint16(pk[0x1C:0x1E], d.Checksum)
+	}
+
+	return pk, nil
+}
+
+func (d *MonDocument) ToJSON() ([]byte, error) {
+	b, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+func (d *MonDocument) ToYAML() ([]byte, error) {
+	b := new(bytes.Buffer)
+	e := yaml.NewEncoder(b)
+	e.SetIndent(2)
+
+	if err := e.Encode(d); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), e.Close()
+}
+
+func ParseMonDocumentJSON(b []byte) (MonDocument, error) {
+	var d MonDocument
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+
+	err := dec.Decode(&d)
+	return d, err
+}
+
+func ParseMonDocumentYAML(b []byte) (MonDocument, error) {
+	var d MonDocument
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+
+	err := dec.Decode(&d)
+	return d, err
+}
@@ -0,0 +1,116 @@
+package pokemon
+
+import (
+	"bytes"
+	"errors"
+	"postal/utils"
+	"slices"
+	"testing"
+)
+
+// Copies base with the bytes at each offset replaced
+func patchPK3(base []byte, edits map[int]byte) []byte {
+	pk := slices.Clone(base)
+	for off, v := range edits {
+		pk[off] = v
+	}
+	return pk
+}
+
+func TestMonDocumentRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		pk   []byte
+	}{
+		{"broken checksum", patchPK3(MimienBase, map[int]byte{0x1C: 0x00})},
+		{"bytes after nickname terminator", patchPK3(MimienBase, map[int]byte{0x10: 0x12})},
+		{"bytes after ot name terminator", patchPK3(NinaBase, map[int]byte{0x19: 0xFF, 0x1A: 0x12})},
+		{"control code in nickname", patchPK3(NinaBase, map[int]byte{0x08: 0xFC, 0x09: 0x01, 0x0A: 0x02})},
+		{"japanese names past five characters", patchPK3(MimienBase, map[int]byte{0x12: utils.LangJapanese})},
+	}
+	for _, tm := range Templates {
+		tests = append(tests, struct {
+			name string
+			pk   []byte
+		}{tm.Name, tm.Data})
+	}
+
+	formats := []struct {
+		name  string
+		write func(*MonDocument) ([]byte, error)
+		read  func([]byte) (MonDocument, error)
+	}{
+		{"json", (*MonDocument).ToJSON, ParseMonDocumentJSON},
+		{"yaml", (*MonDocument).ToYAML, ParseMonDocumentYAML},
+	}
+
+	for _, tt := range tests {
+		for _, f := range formats {
+			t.Run(tt.name+"/"+f.name, func(t *testing.T) {
+				d, err := NewMonDocument(tt.pk)
+				if err != nil {
+					t.Fatalf("NewMonDocument: %v", err)
+				}
+
+				b, err := f.write(&d)
+				if err != nil {
+					t.Fatalf("write: %v", err)
+				}
+
+				back, err := f.read(b)
+				if err != nil {
+					t.Fatalf("read: %v\n%s", err, b)
+				}
+
+				pk, err := back.ToPK3()
+				if err != nil {
+					t.Fatalf("ToPK3: %v", err)
+				}
+				if !bytes.Equal(pk, tt.pk) {
+					t.Fatalf("round trip changed the bytes\ngot  % X\nwant % X", pk, tt.pk)
+				}
+			})
+		}
+	}
+}
+
+func TestMonDocumentToPK3Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(*MonDocument)
+		err  error
+	}{
+		{
+			name: "nickname too long for japanese",
+			edit: func(d *MonDocument) {
+				d.Language = utils.LangJapanese
+				d.Nickname.Text = "MIMIENS"
+			},
+			err: utils.ErrOutOfRange,
+		},
+		{
+			name: "ot name with a character the table lacks",
+			edit: func(d *MonDocument) { d.OTName.Text = "☃" },
+			err:  utils.ErrBadTextChar,
+		},
+		{
+			name: "bad escape in nickname",
+			edit: func(d *MonDocument) { d.Nickname.Text = "[ZZ]" },
+			err:  utils.ErrBadTextEscape,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewMonDocument(MimienBase)
+			if err != nil {
+				t.Fatalf("NewMonDocument: %v", err)
+			}
+
+			tt.edit(&d)
+			if _, err := d.ToPK3(); !errors.Is(err, tt.err) {
+				t.Fatalf("ToPK3 error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
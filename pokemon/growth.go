@@ -0,0 +1,91 @@
+package pokemon
+
+import (
+	"fmt"
+	vals "postal/game"
+	"postal/utils"
+)
+
+const MaxLevel = 100
+
+// ExperienceForLevel is the least experience a mon on growth rate rate
+// needs to be at level. With a ROM loaded the tables come out of it, so
+// glitch rates past the six real ones get whatever the game would read
+// after them. Without one they have no table at all
+func ExperienceForLevel(rate uint, level uint8) (uint32, error) {
+	if level > MaxLevel {
+		return 0, utils.ErrOutOfRange
+	}
+
+	if r := vals.CurrentROM(); r != nil {
+		if exp, ok := r.Experience(rate, int(level)); ok {
+			return exp, nil
+		}
+	}
+
+	table, ok := ExperienceMap[int(rate)]
+	if !ok {
+		return 0, fmt.Errorf("%w: %d", utils.ErrNoGrowthTable, rate)
+	}
+
+	// The built in tables start at level 1
+	if level == 0 {
+		return 0, nil
+	}
+	return uint32(table[level-1]), nil
+}
+
+// LevelForExperience walks the table the way the game does, stopping at
+// the first level that needs more than exp. Glitch tables aren't sorted
+// so a bigger exp can give a lower level
+func LevelForExperience(rate uint, exp uint32) (uint8, error) {
+	level := uint8(1)
+	for level <= MaxLevel {
+		need, err := ExperienceForLevel(rate, level)
+		if err != nil {
+			return 0, err
+		}
+
+		if need > exp {
+			break
+		}
+		level++
+	}
+	return level - 1, nil
+}
+
+// ExperienceToNextLevel is how much more experience it takes to go up a
+// level, 0 at level 100
+func ExperienceToNextLevel(rate uint, exp uint32) (uint32, error) {
+	level, err := LevelForExperience(rate, exp)
+	if err != nil || level >= MaxLevel {
+		return 0, err
+	}
+
+	next, err := ExperienceForLevel(rate, level+1)
+	if err != nil {
+		return 0, err
+	}
+	return next - min(exp, next), nil
+}
+
+// Glitch species without a ROM loaded use the Glitched! entry's rate
+func SpeciesGrowthRate(species uint16) uint {
+	return speciesData(species).ExpRate
+}
+
+func (p *PStructure) GrowthRate() uint {
+	return SpeciesGrowthRate(p.Sub0.Species)
+}
+
+// SetLevel gives the mon the least experience its species needs for
+// level. The party level and stats are left alone
+func (p *PStructure) SetLevel(level uint8) error {
+	exp, err := ExperienceForLevel(p.GrowthRate(), level)
+	if err != nil {
+		return err
+	}
+
+	p.Sub0.Experience = exp
+	return nil
+}
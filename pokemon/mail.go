@@ -0,0 +1,216 @@
+package pokemon
+
+import (
+	"encoding/binary"
+	"fmt"
+	"iter"
+	vals "postal/game"
+	"postal/utils"
+	"strconv"
+	"strings"
+)
+
+// Word slots in the order they're written over the mon
+const (
+	MailPIDHI = iota
+	MailPIDLO
+	MailSID
+	MailTID
+)
+
+// MailField is a 16 bit value read out of a decrypted substructure
+type MailField struct {
+	Name   string
+	Sub    int
+	Offset int
+}
+
+var MailFields = []MailField{
+	{"Species", 0, 0x0},
+	{"Held Item", 0, 0x2},
+	{"Move 1", 1, 0x0},
+	{"Move 2", 1, 0x2},
+	{"Move 3", 1, 0x4},
+	{"Move 4", 1, 0x6},
+	{"HP/Atk EVs", 2, 0x0},
+	{"Def/Spe EVs", 2, 0x2},
+	{"SpA/SpD EVs", 2, 0x4},
+}
+
+type MailSolution struct {
+	Words [4]uint16
+	PID   uint32
+	OTID  uint32
+}
+
+// MailSolutions holds every word combination that turns a mon's field
+// into the target value. Only three of the words decide the field, the
+// fourth only changes the other half of the key so any word fits there
+type MailSolutions struct {
+	Field  MailField
+	Target uint16
+	high   bool
+	fixed  [][3]uint16
+	words  []uint16
+}
+
+func newMailSolution(w [4]uint16) MailSolution {
+	return MailSolution{
+		Words: w,
+		PID:   CombineU16Split(w[MailPIDHI], w[MailPIDLO]),
+		OTID:  CombineU16Split(w[MailSID], w[MailTID]),
+	}
+}
+
+// ParseMailWord takes an easy chat word or a raw hex value
+func ParseMailWord(s string) (uint16, error) {
+	if v, err := vals.WordLookup(utils.SanitizeWordSearch(s)); err == nil {
+		return v, nil
+	}
+
+	n, err := strconv.ParseUint(strings.TrimPrefix(s, "0x"), 16, 16)
+	if err != nil {
+		return 0, fmt.Errorf("unknown mail word %q", s)
+	}
+
+	return uint16(n), nil
+}
+
+// MailIDs gives the PID and OTID once words are written over the mon,
+// zero words leave that half as it is
+func (p *PStructure) MailIDs(words [4]uint16) (uint32, uint32) {
+	h, l := p.GetPIDSplit()
+	tid, sid := p.GetTIDSIDComboPair()
+
+	vl := [4]uint16{h, l, sid, tid}
+	for i, w := range words {
+		if w > 0 {
+			vl[i] = w
+		}
+	}
+
+	return CombineU16Split(vl[MailPIDHI], vl[MailPIDLO]), CombineU16Split(vl[MailSID], vl[MailTID])
+}
+
+// MailFieldValue reads the field from the decrypted substructs
+func (p *PStructure) MailFieldValue(f MailField) uint16 {
+	sub := p.GetSubstructDataInOrder(false)[f.Sub]
+	return binary.LittleEndian.Uint16(sub[f.Offset:])
+}
+
+// mailKeyNeeded gives the key half that makes f read as target for each
+// of the 24 orders, and whether it's the high half of the key
+func (p *PStructure) mailKeyNeeded(f MailField, target uint16) ([24]uint16, bool) {
+	plain := p.GetSubstructDataInOrder(false)
+	old := p.GetMonSubStructOrder()
+	key := p.GetEncryptionKey()
+	shift := 16 * ((f.Offset % 4) / 2)
+
+	var need [24]uint16
+	for o := range need {
+		ord := (&PStructure{PID: uint32(o)}).GetMonSubStructOrder()
+		src := old[FindSubstructPosition(ord, f.Sub)]
+		w := binary.LittleEndian.Uint32(plain[src][f.Offset&^3:])
+		need[o] = uint16((w^key)>>shift) ^ target
+	}
+
+	return need, shift != 0
+}
+
+// SolveMail finds the words that make f read as target once the mail
+// overwrites the PID and OTID. The substructs stay encrypted with the
+// old key, so the game reads them back in the new order xored with
+// both keys. The field's half of the new key has to cancel that out
+func SolveMail(p *PStructure, f MailField, target uint16, words []uint16) *MailSolutions {
+	need, high := p.mailKeyNeeded(f, target)
+
+	isWord := make([]bool, 0x10000)
+	for _, w := range words {
+		isWord[w] = true
+	}
+
+	s := MailSolutions{
+		Field:  f,
+		Target: target,
+		high:   high,
+		words:  words,
+	}
+
+	// The low half is PIDLO ^ TID and the high half PIDHI ^ SID
+	for _, h := range words {
+		for _, l := range words {
+			o := CombineU16Split(h, l) % 24
+			if s.high {
+				if sid := need[o] ^ h; isWord[sid] {
+					s.fixed = append(s.fixed, [3]uint16{h, l, sid})
+				}
+			} else {
+				if tid := need[o] ^ l; isWord[tid] {
+					s.fixed = append(s.fixed, [3]uint16{h, l, tid})
+				}
+			}
+		}
+	}
+
+	return &s
+}
+
+func (s *MailSolutions) Count() int {
+	return len(s.fixed) * len(s.words)
+}
+
+// All walks every solution with the free word changing slowest, so the
+// first results cover all the distinct ways to hit the target
+func (s *MailSolutions) All() iter.Seq[MailSolution] {
+	return func(yield func(MailSolution) bool) {
+		for _, free := range s.words {
+			for _, v := range s.fixed {
+				w := [4]uint16{v[0], v[1], free, v[2]}
+				if s.high {
+					w = [4]uint16{v[0], v[1], v[2], free}
+				}
+
+				if !yield(newMailSolution(w)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// BadEggCheck is the check the game runs every time it decrypts a mon.
+// After a mail edit the old substructs get read back with the new key,
+// so the sum it computes rarely lines up with the stored one
+type BadEggCheck struct {
+	Stored   uint16
+	Computed uint16
+	Flagged  bool
+}
+
+// Once flagged the game stops checking, the mon stays a Bad Egg
+func (c BadEggCheck) WillBeBadEgg() bool {
+	return c.Flagged || c.Stored != c.Computed
+}
+
+func (p *PStructure) CheckBadEgg() BadEggCheck {
+	return BadEggCheck{
+		Stored:   p.Checksum,
+		Computed: p.ComputeChecksum(),
+		Flagged:  p.InterpretFlags().IsBadEgg,
+	}
+}
+
+// MarkBadEgg sets the same bits the game does on a checksum mismatch
+func (p *PStructure) MarkBadEgg() {
+	p.Flags |= BadEggMask | EggNameMask
+	p.Sub3.IsEgg = 1
+}
+
+// SimulateDecrypt runs the game's check and flags the mon if it fails
+func (p *PStructure) SimulateDecrypt() BadEggCheck {
+	c := p.CheckBadEgg()
+	if c.WillBeBadEgg() && !c.Flagged {
+		p.MarkBadEgg()
+	}
+	return c
+}
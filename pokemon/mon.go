@@ -1,11 +1,12 @@
 package pokemon
 
 import (
+	"bytes"
 	"encoding/binary"
+	"fmt"
 	vals "postal/game"
 	"postal/utils"
 	"reflect"
-	"strings"
 )
 
 const (
@@ -65,7 +66,14 @@ const (
 	UR28                        = UR27 << 1
 	UR29                        = UR28 << 1
 	UR30                        = UR29 << 1
-	FatefulMask                 = WorldMask << 4
+	FatefulMask                 = WorldMask << 5
+
+	SleepMask     uint32 = 0b111
+	PoisonMask    uint32 = 1 << 3
+	BurnMask             = PoisonMask << 1
+	FreezeMask           = BurnMask << 1
+	ParalysisMask        = FreezeMask << 1
+	ToxicMask            = ParalysisMask << 1
 )
 
 type (
@@ -194,6 +202,7 @@ func GeneratePokemonFromRawData(data []byte, isBoxMon bool, isDecrypted bool) PS
 
 	mon.PID = binary.LittleEndian.Uint32(utils.GetSliceFromRawData(data, 0x0, 4))
 	mon.OTID = binary.LittleEndian.Uint32(utils.GetSliceFromRawData(data, 0x4, 4))
+	mon.Lang = utils.GetSliceFromRawData(data, 0x12, 1)[0]
 	nick := utils.GetSliceFromRawData(data, 0x8, 10)
 
 	empty := true
@@ -205,7 +214,7 @@ func GeneratePokemonFromRawData(data []byte, isBoxMon bool, isDecrypted bool) PS
 	}
 
 	if !empty {
-		mon.NickName = utils.GenerateNickNameString(nick)
+		mon.NickName = utils.GenerateNickNameString(nick, mon.Lang)
 		empty = false
 	}
 
@@ -219,10 +228,9 @@ func GeneratePokemonFromRawData(data []byte, isBoxMon bool, isDecrypted bool) PS
 	}
 
 	if !empty {
-		mon.OTName = utils.GenerateOTNameString(otName)
+		mon.OTName = utils.GenerateOTNameString(otName, mon.Lang)
 	}
 
-	mon.Lang = utils.GetSliceFromRawData(data, 0x12, 1)[0]
 	mon.Flags = utils.GetSliceFromRawData(data, 0x13, 1)[0]
 	mon.Markings = utils.GetSliceFromRawData(data, 0x1B, 1)[0]
 	mon.Checksum = binary.LittleEndian.Uint16(utils.GetSliceFromRawData(data, 0x1C, 2))
@@ -393,7 +401,7 @@ func GenerateSubStructure3(data []byte) SubStruct3 {
 		MetLevel:    uint8(origin & MetLevelMask),
 		MetGame:     uint8(origin & GameOriginMask >> 7),
 		Ball:        uint8(origin & BallMask >> 11),
-		OTGender:    uint8(origin & TGenderMask >> 14),
+		OTGender:    uint8(origin & TGenderMask >> 15),
 
 		HpIV:       uint8(stats & HPMask),
 		AtkIV:      uint8(stats & AtkMask >> 5),
@@ -464,36 +472,23 @@ func (p *PStructure) GetEncryptionKey() uint32 {
 	return p.PID ^ p.OTID
 }
 
-func calcHPStat(level uint8, base uint, evs uint8, iv uint8) uint16 {
-	evc := uint(evs) / 4
-	return uint16((((2*base + uint(iv) + evc) * uint(level)) / 100) + uint(level) + 10)
-}
-
-func calcOtherStat(level uint8, base uint, nat float32, evs uint8, iv uint8) uint16 {
-	evc := uint(evs) / 4
-	p1 := (((2*base + uint(iv) + evc) * uint(level)) / 100) + 5
-	return uint16(float32(p1) * nat)
+// Level 0 when the growth rate has no table
+func DetermineLevelFromExperience(exp uint, tbIndex int) int {
+	l, _ := LevelForExperience(uint(tbIndex), uint32(exp))
+	return int(l)
 }
 
-func DetermineLevelFromExperience(exp uint, tbIndex int) int {
-	table := ExperienceMap[tbIndex]
-	l := 0
-	for range table {
-		if exp >= table[l] {
-			l++
-		}
+// Base data for a species. Glitch species get the Glitched! entry unless
+// a loaded ROM has their real one
+func speciesData(species uint16) vals.SpeciesData {
+	if d, ok := vals.SpeciesInfo(species); ok {
+		return d
 	}
-	return l
+	return vals.SpeciesDataList[SpeciesIndexMax]
 }
 
 func CalculateLevel(pk *PStructure) {
-	species := pk.Sub0.Species
-	var xpr uint
-	if species >= SpeciesIndexMax {
-		xpr = vals.SpeciesDataList[SpeciesIndexMax].ExpRate
-	} else {
-		xpr = vals.SpeciesDataList[species].ExpRate
-	}
+	xpr := speciesData(pk.Sub0.Species).ExpRate
 	pk.Level = uint8(DetermineLevelFromExperience(
 		uint(pk.Sub0.Experience),
 		int(xpr),
@@ -501,46 +496,10 @@ func CalculateLevel(pk *PStructure) {
 	)
 }
 
+// CalculateAllStats fills in the party stats of a box mon the way the
+// game does when it's taken out of the PC
 func CalculateAllStats(pk *PStructure) {
-
-	if pk.Level == 0 && pk.Sub3.IsEgg == 0 {
-		CalculateLevel(pk)
-	}
-
-	level := pk.Level
-	species := pk.Sub0.Species
-
-	var baseStats []uint
-	if species >= SpeciesIndexMax {
-		baseStats = vals.SpeciesDataList[SpeciesIndexMax].BaseStats
-	} else {
-		baseStats = vals.SpeciesDataList[species].BaseStats
-	}
-
-	natureVals, _ := pk.GetNature()
-
-	evSlice := []uint8{pk.Sub2.HpEV, pk.Sub2.AtkEV, pk.Sub2.DefEV,
-		pk.Sub2.SpAtkEV, pk.Sub2.SpDefEV, pk.Sub2.SpeEV,
-	}
-
-	ivSlice := []uint8{pk.Sub3.HpIV, pk.Sub3.AtkIV, pk.Sub3.DefIV,
-		pk.Sub3.SpeIV, pk.Sub3.SpDIV, pk.Sub3.SpeIV,
-	}
-
-	if level > 0 {
-		pk.TotalHP = calcHPStat(level, baseStats[0], evSlice[0], ivSlice[0])
-	}
-
-	remStats := []uint16{pk.Atk, pk.Def, pk.Spa, pk.Spd, pk.Spe}
-	for i := range remStats {
-		remStats[i] = calcOtherStat(
-			level,
-			baseStats[i+1],
-			vals.NatureStatModifier[natureVals][i],
-			evSlice[i+1],
-			ivSlice[i+1],
-		)
-	}
+	pk.RecalculateStats()
 }
 
 func CryptMonSubstructs(data []byte, xkey uint32) []byte {
@@ -565,6 +524,35 @@ func CryptMonSubstructs(data []byte, xkey uint32) []byte {
 	return rs
 }
 
+// DecryptMonData unshuffles and decrypts the substructs of ek3 or box
+// data into pk3 order. Everything outside of them is copied as is
+func DecryptMonData(data []byte) []byte {
+	out := bytes.Clone(data)
+	key := binary.LittleEndian.Uint32(data[0:4]) ^ binary.LittleEndian.Uint32(data[4:8])
+	ord := (&PStructure{PID: binary.LittleEndian.Uint32(data[0:4])}).GetMonSubStructOrder()
+
+	for i, v := range ord {
+		src := data[SubStructOffsetMap[i][0]:SubStructOffsetMap[i][1]]
+		copy(out[SubStructOffsetMap[v][0]:SubStructOffsetMap[v][1]], CryptMonSubstructs(src, key))
+	}
+
+	return out
+}
+
+// EncryptMonData is the inverse of DecryptMonData
+func EncryptMonData(data []byte) []byte {
+	out := bytes.Clone(data)
+	key := binary.LittleEndian.Uint32(data[0:4]) ^ binary.LittleEndian.Uint32(data[4:8])
+	ord := (&PStructure{PID: binary.LittleEndian.Uint32(data[0:4])}).GetMonSubStructOrder()
+
+	for i, v := range ord {
+		src := data[SubStructOffsetMap[v][0]:SubStructOffsetMap[v][1]]
+		copy(out[SubStructOffsetMap[i][0]:SubStructOffsetMap[i][1]], CryptMonSubstructs(src, key))
+	}
+
+	return out
+}
+
 func FindSubstructPosition(ord []int, ss int) int {
 	for i, v := range ord {
 		if ss == v {
@@ -592,7 +580,7 @@ func (p *PStructure) GetPIDSplit() (uint16, uint16) {
 func (p *PStructure) GetGender() (bool, string) {
 	sp, _ := p.GetSpecies()
 
-	ratio := vals.SpeciesDataList[sp].GenderRatio
+	ratio := speciesData(sp).GenderRatio
 	g := p.PID&255 > uint32(ratio)
 
 	if g {
@@ -641,13 +629,14 @@ func (p *PStructure) GetBall() (uint8, string) {
 
 func (p *PStructure) GetSpecies() (uint16, string) {
 	s := p.Sub0.Species
-	if s > SpeciesIndexMax {
+	if vals.CurrentROM() == nil && s > SpeciesIndexMax {
 		return s, "Glitched!"
 	}
 
-	e := vals.SpeciesDataList[s]
-
-	return s, e.Name
+	if n, ok := vals.SpeciesName(s); ok {
+		return s, n
+	}
+	return s, "Glitched!"
 }
 
 func (p *PStructure) GetAbility() (uint8, string) {
@@ -655,20 +644,23 @@ func (p *PStructure) GetAbility() (uint8, string) {
 	ab := p.Sub3.AbilityNum
 
 	if ab > AbilityIndexMax {
-		return 0, vals.Abilities[0]
+		n, _ := vals.AbilityName(0)
+		return 0, n
 	}
 
-	if i > SpeciesIndexMax {
+	if vals.CurrentROM() == nil && i > SpeciesIndexMax {
 		i = 0
 	}
 
-	s := vals.SpeciesDataList[i]
+	s := speciesData(i)
 
-	if ab == 0 {
-		return ab, vals.Abilities[uint8(s.Ab0)]
+	id := s.Ab0
+	if ab != 0 {
+		id = s.Ab1
 	}
 
-	return ab, vals.Abilities[uint8(s.Ab1)]
+	n, _ := vals.AbilityName(uint8(id))
+	return ab, n
 }
 
 func (p *PStructure) GetMoveStrings() []string {
@@ -676,8 +668,8 @@ func (p *PStructure) GetMoveStrings() []string {
 	moves := p.Sub1.Moves
 
 	for _, v := range moves {
-		if v < MoveIndexMax {
-			ret = append(ret, vals.MovesList[v])
+		if n, ok := vals.MoveName(v); ok {
+			ret = append(ret, n)
 		} else {
 			ret = append(ret, "Glitched!")
 		}
@@ -695,26 +687,50 @@ func (p *PStructure) GetPPBonus() []uint {
 }
 
 func (p *PStructure) GetHeldItem() (uint16, string) {
-	if p.Sub0.HeldItem > ItemIndexMax {
+	if vals.CurrentROM() == nil && p.Sub0.HeldItem > ItemIndexMax {
 		return p.Sub0.HeldItem, "Unknown"
 	}
 
-	return p.Sub0.HeldItem, vals.Items[p.Sub0.HeldItem]
+	n, _ := vals.ItemName(p.Sub0.HeldItem)
+	return p.Sub0.HeldItem, n
+}
+
+// GetStatusCondition decodes the party status field. Sleep stores
+// the remaining turns in the low bits, the rest are single flags
+func (p *PStructure) GetStatusCondition() (uint32, string) {
+	st := p.Status
+
+	switch {
+	case st&SleepMask != 0:
+		return st, fmt.Sprintf("SLP %d", st&SleepMask)
+	case st&ToxicMask != 0:
+		return st, "TOX"
+	case st&PoisonMask != 0:
+		return st, "PSN"
+	case st&BurnMask != 0:
+		return st, "BRN"
+	case st&FreezeMask != 0:
+		return st, "FRZ"
+	case st&ParalysisMask != 0:
+		return st, "PAR"
+	default:
+		return st, "OK"
+	}
 }
 
 func (p *PStructure) GetLanguage() (uint8, string) {
 	if p.Lang > LanguageMax {
 		return p.Lang, "Unknown"
 	}
-	return p.Lang, vals.LocationMap[p.Lang]
+	return p.Lang, vals.Language[p.Lang]
 }
 
 func (p *PStructure) GetCleanNickname() string {
-	return strings.TrimSpace(strings.ReplaceAll(p.NickName, "\\", ""))
+	return utils.CleanText(p.NickName)
 }
 
 func (p *PStructure) GetCleanOTName() string {
-	return strings.TrimSpace(strings.ReplaceAll(p.OTName, "\\", ""))
+	return utils.CleanText(p.OTName)
 }
 
 func (p *PStructure) GetMetLocation() (uint8, string) {
@@ -759,6 +775,22 @@ func (p *PStructure) IsBlank() bool {
 	return reflect.DeepEqual(pkr, p)
 }
 
+// IsGlitched flags data no legitimate mon can have: out of range
+// species, items or moves, or the Bad Egg flag being set
+func (p *PStructure) IsGlitched() bool {
+	if p.Sub0.Species > SpeciesIndexMax || p.Sub0.HeldItem > ItemIndexMax {
+		return true
+	}
+
+	for _, v := range p.Sub1.Moves {
+		if v > MoveIndexMax {
+			return true
+		}
+	}
+
+	return p.InterpretFlags().IsBadEgg
+}
+
 func (p *PStructure) IsDecrypted() bool {
 	return p.OTID == p.PID
 }
@@ -855,34 +887,38 @@ func (p *PStructure) CalculateOriginValue() uint16 {
 	origin |= uint16(sub.MetLevel)
 	origin |= uint16(sub.MetGame) << 7
 	origin |= uint16(sub.Ball) << 11
-	origin |= uint16(sub.OTGender) << 14
+	origin |= uint16(sub.OTGender) << 15
 
 	return origin
 }
 
 func (p *PStructure) ReplaceOriginByValue(n uint16) {
 	p.Sub3.MetLevel = uint8(n & MetLevelMask)
-	p.Sub3.MetGame = uint8(n & GameOriginMask)
-	p.Sub3.Ball = uint8(n & BallMask)
-	p.Sub3.OTGender = uint8(n & TGenderMask)
+	p.Sub3.MetGame = uint8(n & GameOriginMask >> 7)
+	p.Sub3.Ball = uint8(n & BallMask >> 11)
+	p.Sub3.OTGender = uint8(n & TGenderMask >> 15)
 }
 
-func (p *PStructure) MakeFirstPartRawMon() []byte {
+// MakeFirstPartRawMon fails when a name doesn't fit or has characters
+// the mon's language can't write
+func (p *PStructure) MakeFirstPartRawMon() ([]byte, error) {
 	// All data before substructs
 	pk := make([]byte, 0x20)
 	binary.LittleEndian.PutUint32(pk[:0x4], p.PID)
 	binary.LittleEndian.PutUint32(pk[0x4:0x8], p.OTID)
 
-	n := utils.GenerateNickNameSlice(p.NickName)
-	for i := range NickNameMaxLength {
-		pk[0x8+i] = n[i]
+	nick, err := utils.GenerateNickNameSlice(p.NickName, p.Lang)
+	if err != nil {
+		return nil, fmt.Errorf("nickname: %w", err)
 	}
-
-	o := utils.GenerateOTNameSlice(p.OTName)
-	for i := range TrainerNameMaxLength {
-		pk[0x14+i] = o[i]
+	ot, err := utils.GenerateOTNameSlice(p.OTName, p.Lang)
+	if err != nil {
+		return nil, fmt.Errorf("ot name: %w", err)
 	}
 
+	copy(pk[0x8:0x8+NickNameMaxLength], nick)
+	copy(pk[0x14:0x14+TrainerNameMaxLength], ot)
+
 	pk[0x12] = p.Lang
 	pk[0x13] = p.Flags
 	pk[0x1B] = p.Markings
@@ -890,7 +926,7 @@ func (p *PStructure) MakeFirstPartRawMon() []byte {
 	binary.LittleEndian.PutUint16(pk[0x1C:0x1E], p.Checksum)
 	binary.LittleEndian.PutUint16(pk[0x1E:0x20], p.filler)
 
-	return pk
+	return pk, nil
 }
 
 func (p *PStructure) MakeSecondPartRawMon() []byte {
@@ -898,8 +934,8 @@ func (p *PStructure) MakeSecondPartRawMon() []byte {
 	pk := make([]byte, 0x14)
 
 	binary.LittleEndian.PutUint32(pk[0x0:0x4], p.Status)
-	pk[0x5] = p.Level
-	pk[0x6] = p.MailID
+	pk[0x4] = p.Level
+	pk[0x5] = p.MailID
 
 	binary.LittleEndian.PutUint16(pk[0x6:0x8], p.CurHP)
 	binary.LittleEndian.PutUint16(pk[0x8:0xA], p.TotalHP)
@@ -1011,10 +1047,20 @@ func generateChecksum(d []byte) uint16 {
 	return checksum
 }
 
-func (p *PStructure) ToPK3() []byte {
+// ComputeChecksum sums the decrypted substructs the way the game does
+func (p *PStructure) ComputeChecksum() uint16 {
+	return generateChecksum(bytes.Join(p.GetSubstructDataInOrder(false), nil))
+}
+
+func (p *PStructure) ToPK3() ([]byte, error) {
 	pk := make([]byte, 0x64)
 
-	copy(pk[0x0:0x20], p.MakeFirstPartRawMon())
+	first, err := p.MakeFirstPartRawMon()
+	if err != nil {
+		return nil, err
+	}
+
+	copy(pk[0x0:0x20], first)
 	copy(pk[0x50:0x64], p.MakeSecondPartRawMon())
 
 	sl := p.GetSubstructDataInOrder(false)
@@ -1025,10 +1071,10 @@ func (p *PStructure) ToPK3() []byte {
 	c := generateChecksum(pk[SubStructOffsetMap[0][0]:SubStructOffsetMap[3][1]])
 	binary.LittleEndian.PutUint16(pk[0x1C:0x1E], c)
 
-	return pk
+	return pk, nil
 }
 
-func (p *PStructure) ToEK3() []byte {
+func (p *PStructure) ToEK3() ([]byte, error) {
 	pk := make([]byte, 0x64)
 
 	sl := p.GetSubstructDataInOrder(true)
@@ -1036,18 +1082,24 @@ func (p *PStructure) ToEK3() []byte {
 		copy(pk[SubStructOffsetMap[i][0]:SubStructOffsetMap[i][1]], sl[val])
 	}
 
-	c := generateChecksum(pk[SubStructOffsetMap[0][0]:SubStructOffsetMap[3][1]])
-	binary.LittleEndian.PutUint16(pk[0x1C:0x1E], c)
+	first, err := p.MakeFirstPartRawMon()
+	if err != nil {
+		return nil, err
+	}
 
-	copy(pk[0x0:0x20], p.MakeFirstPartRawMon())
+	copy(pk[0x0:0x20], first)
 	copy(pk[0x50:0x64], p.MakeSecondPartRawMon())
 
-	return pk
+	// The game checksums the decrypted substructs so this has to match
+	// what ToPK3 would write or the mon turns into a Bad Egg
+	binary.LittleEndian.PutUint16(pk[0x1C:0x1E], p.ComputeChecksum())
+
+	return pk, nil
 }
 
 // SaveWithMail Functionally the same process as ToEK3 except we are editing PID, OTID after encryption
 // to simulate the mail edits to an encrypted mon while in game
-func (p *PStructure) SaveWithMail(PID, OTID uint32) []byte {
+func (p *PStructure) SaveWithMail(PID, OTID uint32) ([]byte, error) {
 	pk := make([]byte, 0x64)
 
 	sl := p.GetSubstructDataInOrder(true)
@@ -1055,21 +1107,27 @@ func (p *PStructure) SaveWithMail(PID, OTID uint32) []byte {
 		copy(pk[SubStructOffsetMap[i][0]:SubStructOffsetMap[i][1]], sl[val])
 	}
 
-	c := generateChecksum(pk[SubStructOffsetMap[0][0]:SubStructOffsetMap[3][1]])
-	binary.LittleEndian.PutUint16(pk[0x1C:0x1E], c)
+	first, err := p.MakeFirstPartRawMon()
+	if err != nil {
+		return nil, err
+	}
 
-	copy(pk[0x0:0x20], p.MakeFirstPartRawMon())
+	copy(pk[0x0:0x20], first)
 	copy(pk[0x50:0x64], p.MakeSecondPartRawMon())
 
+	// Mail only touches the PID and OTID, so the checksum left behind is
+	// the one the game wrote for the decrypted data before the edit
+	binary.LittleEndian.PutUint16(pk[0x1C:0x1E], p.ComputeChecksum())
+
 	binary.LittleEndian.PutUint32(pk[0:4], PID)
 	binary.LittleEndian.PutUint32(pk[4:8], OTID)
 
-	return pk
+	return pk, nil
 }
 
 func GetErraticTable() []uint {
 	return []uint{
-		0, 15, 52, 122, 237, 406, 637, 942, 326, 1800,
+		0, 15, 52, 122, 237, 406, 637, 942, 1326, 1800,
 		2369, 3041, 3822, 4719, 5737, 6881, 8155, 9564,
 		11111, 12800, 14632, 16610, 18737, 21012, 23437,
 		26012, 28737, 31610, 34632, 37800, 41111, 44564,
@@ -1107,7 +1165,7 @@ func GetFastTable() []uint {
 
 func GetMediumFastTable() []uint {
 	return []uint{
-		8, 27, 64, 125, 216, 343, 512, 729, 1000, 1331,
+		0, 8, 27, 64, 125, 216, 343, 512, 729, 1000, 1331,
 		1728, 2197, 2744, 3375, 4096, 4913, 5832, 6859,
 		8000, 9261, 10648, 12167, 13824, 15625, 17576, 19683,
 		21952, 24389, 27000, 29791, 32768, 35937, 39304, 42875,
@@ -0,0 +1,237 @@
+package pokemon
+
+import (
+	"context"
+	"encoding/binary"
+	"iter"
+	vals "postal/game"
+	"postal/utils"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+type MailTarget struct {
+	Field MailField
+	Value uint16
+}
+
+// SearchHit is a substruct order and key that gives every target. All
+// the word sets behind it read the mon back the same way, so only the
+// example went through the pipeline
+type SearchHit struct {
+	Order   uint16
+	Key     uint32
+	Mon     PStructure
+	Check   BadEggCheck
+	Count   int
+	Example MailSolution
+
+	index *vals.XORIndex
+}
+
+// MailSearch fans the key space out over Workers goroutines. Targets
+// pin one key half per order, the other half is every value two words
+// can XOR to
+type MailSearch struct {
+	Base      PStructure
+	Targets   []MailTarget
+	ValidOnly bool
+	PostE4    bool
+	Workers   int
+
+	done  atomic.Uint64
+	total atomic.Uint64
+}
+
+type searchJob struct {
+	order uint16
+	hi    uint16
+	los   []uint16
+}
+
+// Solutions walks every word set behind the hit
+func (h *SearchHit) Solutions() iter.Seq[MailSolution] {
+	return func(yield func(MailSolution) bool) {
+		for w := range h.index.Tuples(uint16(h.Key>>16), uint16(h.Key), h.Order) {
+			if !yield(newMailSolution(w)) {
+				return
+			}
+		}
+	}
+}
+
+// Progress is how many keys have been checked out of how many will be
+func (s *MailSearch) Progress() (uint64, uint64) {
+	return s.done.Load(), s.total.Load()
+}
+
+// keyHalves gives the values each key half can take for order o, nil
+// when two targets want different values for the same half
+func (s *MailSearch) keyHalves(idx *vals.XORIndex, need [][24]uint16, high []bool, o int) ([]uint16, []uint16) {
+	var halves [2][]uint16
+
+	for i := range s.Targets {
+		h := 0
+		if high[i] {
+			h = 1
+		}
+
+		v := need[i][o]
+		if halves[h] != nil && halves[h][0] != v {
+			return nil, nil
+		}
+		halves[h] = []uint16{v}
+	}
+
+	for h := range halves {
+		switch {
+		case halves[h] == nil:
+			halves[h] = idx.Values
+		case !idx.Has(halves[h][0]):
+			return nil, nil
+		}
+	}
+
+	return halves[1], halves[0]
+}
+
+func (s *MailSearch) jobs() []searchJob {
+	idx := vals.GetXORIndex(s.PostE4)
+
+	need := make([][24]uint16, len(s.Targets))
+	high := make([]bool, len(s.Targets))
+	for i, t := range s.Targets {
+		need[i], high[i] = s.Base.mailKeyNeeded(t.Field, t.Value)
+	}
+
+	var jobs []searchJob
+	for o := range 24 {
+		his, los := s.keyHalves(idx, need, high, o)
+		for _, hi := range his {
+			jobs = append(jobs, searchJob{order: uint16(o), hi: hi, los: los})
+		}
+	}
+
+	return jobs
+}
+
+// Run blocks until every key is checked or ctx is done, sending hits as
+// they're found. hits is closed when it returns
+func (s *MailSearch) Run(ctx context.Context, hits chan<- SearchHit) error {
+	defer close(hits)
+
+	if len(s.Targets) == 0 {
+		return utils.ErrNoSearchTarget
+	}
+
+	// The mailed mon is the same bytes for every candidate bar the PID
+	// and OTID, so it's only encrypted once
+	ek, err := s.Base.SaveWithMail(s.Base.PID, s.Base.OTID)
+	if err != nil {
+		return err
+	}
+
+	jobs := s.jobs()
+
+	var total uint64
+	for _, j := range jobs {
+		total += uint64(len(j.los))
+	}
+	s.done.Store(0)
+	s.total.Store(total)
+
+	workers := s.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	queue := make(chan searchJob)
+	go func() {
+		defer close(queue)
+		for _, j := range jobs {
+			select {
+			case queue <- j:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	idx := vals.GetXORIndex(s.PostE4)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.work(ctx, idx, ek, queue, hits)
+		}()
+	}
+
+	wg.Wait()
+	return ctx.Err()
+}
+
+func (s *MailSearch) work(ctx context.Context, idx *vals.XORIndex, ek []byte, queue <-chan searchJob, hits chan<- SearchHit) {
+	data := make([]byte, len(ek))
+
+	for j := range queue {
+		for _, lo := range j.los {
+			if ctx.Err() != nil {
+				return
+			}
+
+			hit, ok := s.check(idx, ek, data, j.order, j.hi, lo)
+			s.done.Add(1)
+			if !ok {
+				continue
+			}
+
+			select {
+			case hits <- hit:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+func (s *MailSearch) check(idx *vals.XORIndex, ek, data []byte, order, hi, lo uint16) (SearchHit, bool) {
+	if !idx.CanReachOrder(hi, lo, order) {
+		return SearchHit{}, false
+	}
+
+	var words [4]uint16
+	for w := range idx.Tuples(hi, lo, order) {
+		words = w
+		break
+	}
+	sol := newMailSolution(words)
+
+	copy(data, ek)
+	binary.LittleEndian.PutUint32(data[0:4], sol.PID)
+	binary.LittleEndian.PutUint32(data[4:8], sol.OTID)
+	pk := GeneratePokemonFromRawData(data, false, false)
+
+	for _, t := range s.Targets {
+		if pk.MailFieldValue(t.Field) != t.Value {
+			return SearchHit{}, false
+		}
+	}
+
+	check := pk.CheckBadEgg()
+	if s.ValidOnly && check.WillBeBadEgg() {
+		return SearchHit{}, false
+	}
+
+	return SearchHit{
+		Order:   order,
+		Key:     uint32(hi)<<16 | uint32(lo),
+		Mon:     pk,
+		Check:   check,
+		Count:   idx.TupleCount(hi, lo, order),
+		Example: sol,
+		index:   idx,
+	}, true
+}
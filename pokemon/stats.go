@@ -0,0 +1,139 @@
+package pokemon
+
+import (
+	vals "postal/game"
+)
+
+// Shedinja's max HP is always 1 no matter what its stats say
+const ShedinjaSpecies = 303
+
+// PartyStats are the values the game keeps after the substructs of a
+// party mon. Box mons don't have them and get them worked out again
+// whenever they leave the PC
+type PartyStats struct {
+	Level uint8
+	HP    uint16
+	Atk   uint16
+	Def   uint16
+	Spe   uint16
+	SpA   uint16
+	SpD   uint16
+}
+
+func calcHPStat(level uint8, base uint, evs uint8, iv uint8) uint16 {
+	evc := uint(evs) / 4
+	return uint16((((2*base + uint(iv) + evc) * uint(level)) / 100) + uint(level) + 10)
+}
+
+// Nature boosts and drops are done in 16 bits before dividing, the same
+// way the game does them
+func calcOtherStat(level uint8, base uint, nat float32, evs uint8, iv uint8) uint16 {
+	evc := uint(evs) / 4
+	n := uint16((((2*base + uint(iv) + evc) * uint(level)) / 100) + 5)
+
+	switch {
+	case nat > 1:
+		return n * 110 / 100
+	case nat < 1:
+		return n * 90 / 100
+	}
+	return n
+}
+
+// ComputeStats works out the party stats the game would give the mon
+// from its species base stats, experience, nature, IVs and EVs
+func (p *PStructure) ComputeStats() PartyStats {
+	level, _ := LevelForExperience(p.GrowthRate(), p.Sub0.Experience)
+
+	// HP, Atk, Def, SpA, SpD, Spe like the base stats and nature table
+	base := speciesData(p.Sub0.Species).BaseStats
+	evs := []uint8{p.Sub2.HpEV, p.Sub2.AtkEV, p.Sub2.DefEV, p.Sub2.SpAtkEV, p.Sub2.SpDefEV, p.Sub2.SpeEV}
+	ivs := []uint8{p.Sub3.HpIV, p.Sub3.AtkIV, p.Sub3.DefIV, p.Sub3.SpAtkIV, p.Sub3.SpDIV, p.Sub3.SpeIV}
+
+	nature, _ := p.GetNature()
+	mods := vals.NatureStatModifier[nature]
+
+	other := make([]uint16, 5)
+	for i := range other {
+		other[i] = calcOtherStat(level, base[i+1], mods[i], evs[i+1], ivs[i+1])
+	}
+
+	s := PartyStats{
+		Level: level,
+		HP:    calcHPStat(level, base[0], evs[0], ivs[0]),
+		Atk:   other[0],
+		Def:   other[1],
+		SpA:   other[2],
+		SpD:   other[3],
+		Spe:   other[4],
+	}
+
+	if p.Sub0.Species == ShedinjaSpecies {
+		s.HP = 1
+	}
+	return s
+}
+
+// StoredStats are the party stats as they are in the structure right now
+func (p *PStructure) StoredStats() PartyStats {
+	return PartyStats{
+		Level: p.Level,
+		HP:    p.TotalHP,
+		Atk:   p.Atk,
+		Def:   p.Def,
+		Spe:   p.Spe,
+		SpA:   p.Spa,
+		SpD:   p.Spd,
+	}
+}
+
+// StatsMatch is false when the stored party stats aren't what the game
+// would work out for the mon, like after a mail edit changes its species
+func (p *PStructure) StatsMatch() bool {
+	return p.StoredStats() == p.ComputeStats()
+}
+
+// RecalculateStats writes the computed party stats back into the mon the
+// way the retail games do. Current HP moves by however much max HP did
+// with nothing stopping it going to 0 or wrapping below it, which is the
+// Pomeg glitch. A mon with no HP and no max HP gets filled up
+func (p *PStructure) RecalculateStats() {
+	p.recalculateStats(false)
+}
+
+// RecalculateStatsClampHP is RecalculateStats with the fix the games never
+// shipped, current HP that would drop to 0 or below is kept at 1
+func (p *PStructure) RecalculateStatsClampHP() {
+	p.recalculateStats(true)
+}
+
+func (p *PStructure) recalculateStats(clamp bool) {
+	s := p.ComputeStats()
+	oldMax := int(p.TotalHP)
+	cur := int(p.CurHP)
+
+	p.Level = s.Level
+	p.TotalHP = s.HP
+	p.Atk = s.Atk
+	p.Def = s.Def
+	p.Spe = s.Spe
+	p.Spa = s.SpA
+	p.Spd = s.SpD
+
+	switch {
+	case p.Sub0.Species == ShedinjaSpecies:
+		if cur != 0 || oldMax == 0 {
+			cur = 1
+		}
+	case cur == 0 && oldMax == 0:
+		cur = int(s.HP)
+	case cur != 0:
+		cur += int(s.HP) - oldMax
+		if clamp {
+			cur = max(cur, 1)
+		}
+	}
+
+	// The game stores the 32 bit result in 16 bits, so -1 becomes 65535
+	p.CurHP = uint16(cur)
+}
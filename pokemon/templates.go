@@ -72,3 +72,27 @@ var (
 		0x00, 0x00, 0x00, 0x00,
 	}
 )
+
+// Template is a mon to start a glitch route from
+type Template struct {
+	Name        string
+	Description string
+	Data        []byte
+	Decrypted   bool
+}
+
+func (t *Template) Mon() PStructure {
+	return GeneratePokemonFromRawData(t.Data, false, t.Decrypted)
+}
+
+// Templates lists the built in bases, the first four are the in game
+// trades which carry the same PID and OTID on every cartridge
+var Templates = []Template{
+	{"Mimien", "Mr. Mime traded for an Abra on Route 2, the grab ACE base is built from it", MimienBase, true},
+	{"Nina", "Nidorina traded for a Nidorino on Route 11 in FireRed", NinaBase, true},
+	{"Nino", "Nidorino traded for a Nidorina on Route 11 in LeafGreen", NinoBase, true},
+	{"Ch'Ding", "Farfetch'd traded for a Spearow in Vermilion City", ChdingBase, true},
+	{"Grab ACE", "Mimien with PIDHI 0x2000, decrypts to species 0x351 for the grab ACE setup", GrabAceSpecies0x351, true},
+	{"Fast Clone", "Empty mon with species and checksum 0x3200, used for the fast clone setup", FastCloneSpecies0x3200, true},
+	{"Blank", "All zero structure, the same mon postal starts with", BlankSpecies, true},
+}
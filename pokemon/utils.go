@@ -9,23 +9,23 @@ import (
 // when searching a list or map that is commonly used
 
 func NumToSpeciesName(n uint16) (string, error) {
-	if n > SpeciesIndexMax {
-		return "", utils.ErrOutOfRange
+	if name, ok := vals.SpeciesName(n); ok {
+		return name, nil
 	}
 
-	return vals.SpeciesDataList[n].Name, nil
+	return "", utils.ErrOutOfRange
 }
 
 func NumToMoveName(n uint16) (string, error) {
-	if n > MoveIndexMax {
-		return "", utils.ErrOutOfRange
+	if name, ok := vals.MoveName(n); ok {
+		return name, nil
 	}
 
-	return vals.MovesList[n], nil
+	return "", utils.ErrOutOfRange
 }
 
 func NumToItemName(n uint16) (string, error) {
-	if val, ok := vals.Items[n]; ok {
+	if val, ok := vals.ItemName(n); ok {
 		return val, nil
 	}
 
@@ -0,0 +1,121 @@
+package save
+
+import (
+	"bytes"
+	"encoding/binary"
+	"postal/utils"
+)
+
+// SectionReport is what the footer of one section says next to what it
+// should say. Sections are listed in the order they sit in the block
+type SectionReport struct {
+	Offset           uint
+	ID               uint16
+	Signature        uint32
+	SignatureValid   bool
+	StoredChecksum   uint16
+	ComputedChecksum uint16
+	SaveIndex        uint32
+}
+
+type BlockReport struct {
+	Name       string
+	Offset     uint
+	Active     bool
+	SaveIndex  uint32
+	Sections   [SectionCount]SectionReport
+	Missing    []uint16
+	Duplicated []uint16
+}
+
+type IntegrityReport struct {
+	Blocks [2]BlockReport
+}
+
+func (r *SectionReport) IDValid() bool {
+	return r.ID < SectionCount
+}
+
+func (r *SectionReport) ChecksumValid() bool {
+	return r.IDValid() && r.StoredChecksum == r.ComputedChecksum
+}
+
+func (r *SectionReport) IsValid() bool {
+	return r.SignatureValid && r.ChecksumValid()
+}
+
+func (b *BlockReport) IsValid() bool {
+	if len(b.Missing) > 0 || len(b.Duplicated) > 0 {
+		return false
+	}
+
+	for i := range b.Sections {
+		if !b.Sections[i].IsValid() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CheckIntegrity reads the footers of every section in both blocks
+// without caring which one is loaded
+func (s *RawSaveFile) CheckIntegrity() (IntegrityReport, error) {
+	r := IntegrityReport{}
+	if len(s.Data) != int(SaveSize) {
+		return r, utils.ErrSaveIncorrectFileSize
+	}
+
+	active, _ := s.getActiveSaveBlockOffsetAndSaveCount()
+
+	for i, name := range []string{"A", "B"} {
+		offset := getBlockOffsets()[i]
+		r.Blocks[i] = s.checkBlock(offset)
+		r.Blocks[i].Name = name
+		r.Blocks[i].Active = offset == active
+	}
+
+	return r, nil
+}
+
+func (s *RawSaveFile) checkBlock(offset uint) BlockReport {
+	b := BlockReport{
+		Offset:    offset,
+		SaveIndex: s.getBlockSaveIndex(offset),
+	}
+
+	var count [SectionCount]int
+
+	for i := range b.Sections {
+		pos := offset + uint(i)*SaveSectionSize
+		data := s.Data[pos : pos+SaveSectionSize]
+		footer := getSectionFooterOffsets()
+
+		sec := SectionReport{
+			Offset:         pos,
+			ID:             binary.LittleEndian.Uint16(data[footer[1]:]),
+			StoredChecksum: binary.LittleEndian.Uint16(data[footer[2]:]),
+			Signature:      binary.LittleEndian.Uint32(data[footer[3]:]),
+			SignatureValid: bytes.Equal(getSignatureSlice(), data[footer[3]:footer[3]+4]),
+			SaveIndex:      binary.LittleEndian.Uint32(data[footer[4]:]),
+		}
+
+		if sec.IDValid() {
+			sec.ComputedChecksum = generateChecksum(data, int(GetSectionSizes()[sec.ID]))
+			count[sec.ID]++
+		}
+
+		b.Sections[i] = sec
+	}
+
+	for id, n := range count {
+		switch {
+		case n == 0:
+			b.Missing = append(b.Missing, uint16(id))
+		case n > 1:
+			b.Duplicated = append(b.Duplicated, uint16(id))
+		}
+	}
+
+	return b
+}
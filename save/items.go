@@ -0,0 +1,143 @@
+package save
+
+import (
+	"encoding/binary"
+	"postal/utils"
+)
+
+const (
+	PCPocket = iota
+	ItemsPocket
+	KeyItemsPocket
+	BallsPocket
+	TMCasePocket
+	BerriesPocket
+)
+
+var PocketNames = []string{"PC", "Items", "Key Items", "Balls", "TM Case", "Berries"}
+
+type (
+	ItemSlot struct {
+		ID       uint16
+		Quantity uint16
+	}
+
+	Pocket struct {
+		Name  string
+		Slots []ItemSlot
+	}
+
+	Bag struct {
+		Pockets []Pocket
+		Money   uint32
+		Coins   uint16
+	}
+)
+
+func (t RawTeamAndItems) getPocketData(i int) []byte {
+	return [][]byte{
+		t.PCItems,
+		t.Items,
+		t.KeyItems,
+		t.Balls,
+		t.TMCase,
+		t.Berries,
+	}[i]
+}
+
+// Quantities are XORed with the low half of the security key, except for
+// the PC which the game never encrypts
+func (b *SaveBlock) getPocketKey(i int) uint16 {
+	if i == PCPocket {
+		return 0
+	}
+	return uint16(b.GetSecurityKey())
+}
+
+func (b *SaveBlock) GetPocket(i int) (Pocket, error) {
+	if i < 0 || i >= len(PocketNames) {
+		return Pocket{}, utils.ErrOutOfRange
+	}
+
+	data := b.GetRawTeamAndItems().getPocketData(i)
+	key := b.getPocketKey(i)
+
+	p := Pocket{
+		Name:  PocketNames[i],
+		Slots: make([]ItemSlot, b.layout.Pockets[i]),
+	}
+
+	for j := range p.Slots {
+		p.Slots[j] = ItemSlot{
+			ID:       binary.LittleEndian.Uint16(data[j*4 : j*4+2]),
+			Quantity: binary.LittleEndian.Uint16(data[j*4+2:j*4+4]) ^ key,
+		}
+	}
+
+	return p, nil
+}
+
+func (b *SaveBlock) SetPocket(i int, slots []ItemSlot) error {
+	if i < 0 || i >= len(PocketNames) {
+		return utils.ErrOutOfRange
+	}
+
+	if uint(len(slots)) > b.layout.Pockets[i] {
+		return utils.ErrOutOfRange
+	}
+
+	data := b.GetRawTeamAndItems().getPocketData(i)
+	key := b.getPocketKey(i)
+
+	for j, v := range slots {
+		binary.LittleEndian.PutUint16(data[j*4:j*4+2], v.ID)
+		binary.LittleEndian.PutUint16(data[j*4+2:j*4+4], v.Quantity^key)
+	}
+
+	b.sections[1].dirty = true
+	return nil
+}
+
+func (b *SaveBlock) GetMoney() uint32 {
+	return binary.LittleEndian.Uint32(b.GetRawTeamAndItems().Money) ^ b.GetSecurityKey()
+}
+
+func (b *SaveBlock) SetMoney(n uint32) {
+	binary.LittleEndian.PutUint32(b.GetRawTeamAndItems().Money, n^b.GetSecurityKey())
+	b.sections[1].dirty = true
+}
+
+func (b *SaveBlock) GetCoins() uint16 {
+	return binary.LittleEndian.Uint16(b.GetRawTeamAndItems().Coins) ^ b.GetDirectPlayerXKey()
+}
+
+func (b *SaveBlock) SetCoins(n uint16) {
+	binary.LittleEndian.PutUint16(b.GetRawTeamAndItems().Coins, n^b.GetDirectPlayerXKey())
+	b.sections[1].dirty = true
+}
+
+func (b *SaveBlock) GetBag() Bag {
+	bag := Bag{
+		Pockets: make([]Pocket, len(PocketNames)),
+		Money:   b.GetMoney(),
+		Coins:   b.GetCoins(),
+	}
+
+	for i := range bag.Pockets {
+		bag.Pockets[i], _ = b.GetPocket(i)
+	}
+
+	return bag
+}
+
+func (b *SaveBlock) SetBag(bag Bag) error {
+	for i := range bag.Pockets {
+		if err := b.SetPocket(i, bag.Pockets[i].Slots); err != nil {
+			return err
+		}
+	}
+
+	b.SetMoney(bag.Money)
+	b.SetCoins(bag.Coins)
+	return nil
+}
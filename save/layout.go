@@ -0,0 +1,115 @@
+package save
+
+import (
+	"encoding/binary"
+	"postal/utils"
+)
+
+type GameVersion int
+
+const (
+	RubySapphire GameVersion = iota
+	Emerald
+	FireRedLeafGreen
+)
+
+const (
+	gameCodeOffset = 0xAC
+	gameCodeRS     = 0x0
+	gameCodeFRLG   = 0x1
+)
+
+var GameVersionNames = map[GameVersion]string{
+	RubySapphire:     "Ruby/Sapphire",
+	Emerald:          "Emerald",
+	FireRedLeafGreen: "Fire Red/Leaf Green",
+}
+
+// Pocket capacities in item slots, each slot is 4 bytes (ID + quantity)
+// Order: PC, Items, Key Items, Balls, TM Case, Berries
+func getFRLGPocketSizes() []uint {
+	return []uint{30, 42, 30, 13, 58, 43}
+}
+
+func getRSPocketSizes() []uint {
+	return []uint{50, 20, 20, 16, 64, 46}
+}
+
+func getEPocketSizes() []uint {
+	return []uint{50, 30, 30, 16, 64, 46}
+}
+
+// SaveLayout holds the offsets that differ between the three Gen 3 save formats.
+// The PC buffer (sections 5-13) is shared by every game so it isn't included
+type SaveLayout struct {
+	Game         GameVersion
+	Trainer      []uint
+	TeamAndItems []uint
+	Pockets      []uint
+	KeyOffset    uint
+	HasKey       bool
+}
+
+func (g GameVersion) String() string {
+	if n, ok := GameVersionNames[g]; ok {
+		return n
+	}
+	return "Unknown"
+}
+
+func GetSaveLayout(g GameVersion) SaveLayout {
+	switch g {
+	case RubySapphire:
+		return SaveLayout{
+			Game:         g,
+			Trainer:      getRSTrainerOffsets(),
+			TeamAndItems: getRSTeamAndItemsOffsets(),
+			Pockets:      getRSPocketSizes(),
+		}
+
+	case Emerald:
+		// Emerald drops the game code and stores the security key in its place
+		return SaveLayout{
+			Game:         g,
+			Trainer:      getETrainerOffsets(),
+			TeamAndItems: getETeamAndItemsOffsets(),
+			Pockets:      getEPocketSizes(),
+			KeyOffset:    getETrainerOffsets()[6],
+			HasKey:       true,
+		}
+
+	default:
+		return SaveLayout{
+			Game:         FireRedLeafGreen,
+			Trainer:      getFRLGTrainerOffsets(),
+			TeamAndItems: getFRLGTeamsAndItemsOffsets(),
+			Pockets:      getFRLGPocketSizes(),
+			KeyOffset:    getFRLGTrainerOffsets()[7],
+			HasKey:       true,
+		}
+	}
+}
+
+// DetectGameVersion reads the game code in the trainer info section.
+// R/S store 0, FR/LG store 1, and anything else is an Emerald security key
+func DetectGameVersion(trainerSection []byte) GameVersion {
+	code := utils.GetSliceFromRawData(trainerSection, gameCodeOffset, 4)
+	if code == nil {
+		return FireRedLeafGreen
+	}
+
+	switch binary.LittleEndian.Uint32(code) {
+	case gameCodeRS:
+		return RubySapphire
+	case gameCodeFRLG:
+		return FireRedLeafGreen
+	default:
+		return Emerald
+	}
+}
+
+// PocketByteSize returns the size in bytes of the pocket at index i
+// in the same order as the pocket sizes
+func (l SaveLayout) PocketByteSize(i int) int {
+	return int(l.Pockets[i]) * 4
+}
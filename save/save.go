@@ -3,6 +3,11 @@ package save
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
 	"os"
 	"postal/utils"
 )
@@ -11,6 +16,15 @@ const (
 	SaveSize        uint = 0x20000
 	SaveSectionSize uint = 0x1000
 	PkSize          uint = 0x64
+	BoxPkSize       uint = 0x50
+	BoxNameSize     uint = 0x9
+
+	SectionCount     = 14
+	PartyMax         = 6
+	PCBoxCount       = 14
+	PCBoxMonCount    = 30
+	pcBufferSection  = 5
+	pcBufferDataSize = 0xF80
 )
 
 func getSignatureSlice() []byte {
@@ -62,7 +76,8 @@ type (
 
 	SaveBlock struct {
 		offset   uint
-		sections [14]SaveBlockSection
+		layout   SaveLayout
+		sections [SectionCount]SaveBlockSection
 	}
 
 	SaveBlockSection struct {
@@ -73,6 +88,7 @@ type (
 		signature []byte
 		saveIndex []byte
 		isValid   bool
+		dirty     bool
 	}
 
 	RawTrainerInfo struct {
@@ -114,46 +130,44 @@ type (
 	}
 )
 
-func GenerateRawSaveData(p string) RawSaveFile {
+// SectionError points at the section of the active block that failed
+type SectionError struct {
+	Offset uint
+	Err    error
+}
+
+func (e *SectionError) Error() string {
+	return fmt.Sprintf("section at 0x%05X: %v", e.Offset, e.Err)
+}
+
+func (e *SectionError) Unwrap() error {
+	return e.Err
+}
+
+func GenerateRawSaveData(p string) (RawSaveFile, error) {
 	save := RawSaveFile{
 		nil,
 		false,
 	}
 
-	if checkFileSize(p) {
-		f, err := os.Open(p)
-		checkError(err)
-
-		defer f.Close()
-
-		buffer := make([]byte, SaveSize)
-
-		_, err = f.Read(buffer)
-		checkError(err)
-
-		save.Data = buffer
-		save.isCorrectSize = true
+	buffer, err := readFileOfSize(p, SaveSize, utils.ErrSaveTooShort, utils.ErrSaveIncorrectFileSize)
+	if err != nil {
+		return save, err
 	}
 
-	return save
+	save.Data = buffer
+	save.isCorrectSize = true
+
+	return save, nil
 }
 
 func GetRawMonDataFromFile(p string) (RawMonData, error) {
-
-	if checkPkSize(p) {
-
-		f, err := os.Open(p)
-		checkError(err)
-
-		buffer := make([]byte, PkSize)
-
-		_, err = f.Read(buffer)
-		checkError(err)
-
-		return buffer, nil
+	buffer, err := readFileOfSize(p, PkSize, utils.ErrPKIncorrectFileSize, utils.ErrPKIncorrectFileSize)
+	if err != nil {
+		return RawMonData{}, err
 	}
 
-	return RawMonData{}, utils.ErrPKIncorrectFileSize
+	return buffer, nil
 }
 
 func (r RawMonData) GetSubStructureArray() [][]byte {
@@ -165,213 +179,318 @@ func (r RawMonData) GetSubStructureArray() [][]byte {
 	return b
 }
 
-func (s *RawSaveFile) getActiveSaveBlockOffsetAndSaveCount() (uint, uint) {
-	// TODO: Add save file size check error
-	blockASaveIndex := getBlockOffsets()[0] + getSectionFooterOffsets()[4]
-	blockBSaveIndex := getBlockOffsets()[1] + getSectionFooterOffsets()[4]
-
-	blockASaveVal := binary.LittleEndian.Uint16(
+func (s *RawSaveFile) getBlockSaveIndex(offset uint) uint32 {
+	return binary.LittleEndian.Uint32(
 		utils.GetSliceFromRawData(
 			s.Data,
-			int(blockASaveIndex),
-			4,
-		),
-	)
-	blockBSaveVal := binary.LittleEndian.Uint16(
-		utils.GetSliceFromRawData(
-			s.Data,
-			int(blockBSaveIndex),
+			int(offset+getSectionFooterOffsets()[4]),
 			4,
 		),
 	)
+}
+
+// The game only loads a block where every section has the signature, a
+// unique id and a matching checksum, so an erased block never wins. When
+// both blocks are valid it takes the higher save index, and so does this
+// when neither is so broken saves can still be looked at. The index is 32
+// bits and can wrap, in which case 0 is newer than 0xFFFFFFFF
+func (s *RawSaveFile) getActiveSaveBlockOffsetAndSaveCount() (uint, uint32) {
+	blockA, blockB := getBlockOffsets()[0], getBlockOffsets()[1]
+
+	blockASaveVal := s.getBlockSaveIndex(blockA)
+	blockBSaveVal := s.getBlockSaveIndex(blockB)
+
+	reportA := s.checkBlock(blockA)
+	reportB := s.checkBlock(blockB)
+
+	switch {
+	case reportA.IsValid() && !reportB.IsValid():
+		return blockA, blockASaveVal
+	case reportB.IsValid() && !reportA.IsValid():
+		return blockB, blockBSaveVal
+	case blockASaveVal == math.MaxUint32 && blockBSaveVal == 0:
+		return blockB, blockBSaveVal
+	case blockBSaveVal == math.MaxUint32 && blockASaveVal == 0:
+		return blockA, blockASaveVal
+	case blockBSaveVal >= blockASaveVal:
+		return blockB, blockBSaveVal
+	}
+
+	return blockA, blockASaveVal
+}
+
+func (s *RawSaveFile) getBackupSaveBlockOffset() uint {
+	offset, _ := s.getActiveSaveBlockOffsetAndSaveCount()
+	if offset == getBlockOffsets()[0] {
+		return getBlockOffsets()[1]
+	}
+	return getBlockOffsets()[0]
+}
+
+// GenerateSaveBlock reads the active block out of the save. Sections with
+// a bad checksum are still loaded and reported with ErrSaveChecksum, since
+// broken saves are usually the ones worth looking at
+func (s *RawSaveFile) GenerateSaveBlock() (SaveBlock, error) {
+	if len(s.Data) != int(SaveSize) {
+		return SaveBlock{}, utils.ErrSaveIncorrectFileSize
+	}
 
-	if blockBSaveVal >= blockASaveVal {
-		return getBlockOffsets()[1], uint(blockBSaveVal)
+	offset, _ := s.getActiveSaveBlockOffsetAndSaveCount()
+	return s.generateSaveBlockAt(offset)
+}
+
+// GenerateBackupSaveBlock reads the older of the two blocks, the one the
+// game falls back to when the active block is corrupted
+func (s *RawSaveFile) GenerateBackupSaveBlock() (SaveBlock, error) {
+	if len(s.Data) != int(SaveSize) {
+		return SaveBlock{}, utils.ErrSaveIncorrectFileSize
 	}
 
-	return getBlockOffsets()[0], uint(blockASaveVal)
+	return s.generateSaveBlockAt(s.getBackupSaveBlockOffset())
 }
 
-func (s *RawSaveFile) GenerateSaveBlock() SaveBlock {
+func (s *RawSaveFile) generateSaveBlockAt(offset uint) (SaveBlock, error) {
 	saveBlock := SaveBlock{}
 	block := SaveBlockSection{}
 
-	offset, _ := s.getActiveSaveBlockOffsetAndSaveCount()
+	var seen [SectionCount]bool
+	var checksumErrs []error
 
 	saveBlock.offset = offset
 	for range GetSectionSizes() {
 		block.offset = offset
-		block.data = utils.GetSliceFromRawData(
+
+		// Sections are copied so edits stay in memory until the block
+		// is written back with WriteSaveBlock
+		block.data = bytes.Clone(utils.GetSliceFromRawData(
 			s.Data,
 			int(offset),
 			int(SaveSectionSize),
-		)
+		))
 		block.sectionID = utils.GetSliceFromRawData(
-			s.Data,
-			int(offset)+int(getSectionFooterOffsets()[1]),
+			block.data,
+			int(getSectionFooterOffsets()[1]),
 			2,
 		)
 		block.checksum = utils.GetSliceFromRawData(
-			s.Data,
-			int(offset)+int(getSectionFooterOffsets()[2]),
+			block.data,
+			int(getSectionFooterOffsets()[2]),
 			2,
 		)
 		block.signature = utils.GetSliceFromRawData(
-			s.Data,
-			int(offset)+int(getSectionFooterOffsets()[3]),
+			block.data,
+			int(getSectionFooterOffsets()[3]),
 			4,
 		)
 		block.saveIndex = utils.GetSliceFromRawData(
-			s.Data,
-			int(offset)+int(getSectionFooterOffsets()[4]),
+			block.data,
+			int(getSectionFooterOffsets()[4]),
 			4,
 		)
 
-		sigValid := bytes.Equal(getSignatureSlice(), block.signature)
+		if !bytes.Equal(getSignatureSlice(), block.signature) {
+			return saveBlock, &SectionError{offset, utils.ErrSaveBadSignature}
+		}
+
+		sectionIDVal := binary.LittleEndian.Uint16(block.sectionID)
+		if sectionIDVal >= SectionCount || seen[sectionIDVal] {
+			return saveBlock, &SectionError{offset, utils.ErrSaveBadSection}
+		}
+		seen[sectionIDVal] = true
 
 		checkSumValid := generateChecksum(
 			block.data,
-			int(GetSectionSizes()[binary.LittleEndian.Uint16(block.sectionID)]),
+			int(GetSectionSizes()[sectionIDVal]),
 		) == binary.LittleEndian.Uint16(block.checksum)
 
-		block.isValid = (sigValid && checkSumValid)
-		sectionIDVal := binary.LittleEndian.Uint16(block.sectionID)
+		if !checkSumValid {
+			checksumErrs = append(checksumErrs, &SectionError{offset, utils.ErrSaveChecksum})
+		}
+
+		block.isValid = checkSumValid
 		saveBlock.sections[sectionIDVal] = block
 		offset += SaveSectionSize
 	}
-	return saveBlock
+
+	saveBlock.layout = GetSaveLayout(DetectGameVersion(saveBlock.sections[0].data))
+	return saveBlock, errors.Join(checksumErrs...)
 }
 
-func (b *SaveBlock) GetDirectPlayerXKey() uint16 {
-	sec := b.sections[0].data
-	return binary.LittleEndian.Uint16(
+// GetOffset is where the block was read from, 0 for block A
+func (b *SaveBlock) GetOffset() uint {
+	return b.offset
+}
+
+func (b *SaveBlock) GetLayout() SaveLayout {
+	return b.layout
+}
+
+func (b *SaveBlock) GetGameVersion() GameVersion {
+	return b.layout.Game
+}
+
+// GetSecurityKey returns the key used to encrypt money and item quantities.
+// Ruby and Sapphire have no key so everything is XORed with zero
+func (b *SaveBlock) GetSecurityKey() uint32 {
+	if !b.layout.HasKey {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint32(
 		utils.GetSliceFromRawData(
-			sec,
-			int(getFRLGTrainerOffsets()[7]),
+			b.sections[0].data,
+			int(b.layout.KeyOffset),
 			4,
 		),
 	)
 }
 
+func (b *SaveBlock) GetDirectPlayerXKey() uint16 {
+	return uint16(b.GetSecurityKey())
+}
+
 func (b *SaveBlock) GetRawTrainerInfo() RawTrainerInfo {
 	tb := b.sections[0].data
-	return RawTrainerInfo{
+	off := b.layout.Trainer
+
+	info := RawTrainerInfo{
 		Offset: b.sections[0].offset,
 		Name: utils.GetSliceFromRawData(
 			tb,
-			int(getFRLGTrainerOffsets()[0]),
+			int(off[0]),
 			7,
 		),
 
 		Gender: utils.GetSliceFromRawData(
 			tb,
-			int(getFRLGTrainerOffsets()[1]),
+			int(off[1]),
 			1,
 		),
 
 		TID: utils.GetSliceFromRawData(
 			tb,
-			int(getFRLGTrainerOffsets()[3]),
+			int(off[3]),
 			2,
 		),
 
 		SID: utils.GetSliceFromRawData(
 			tb,
-			int(getFRLGTrainerOffsets()[3]+2),
+			int(off[3]+2),
 			2,
 		),
 
 		Time: utils.GetSliceFromRawData(
 			tb,
-			int(getFRLGTrainerOffsets()[4]),
+			int(off[4]),
 			5,
 		),
 		Options: utils.GetSliceFromRawData(
 			tb,
-			int(getFRLGTrainerOffsets()[5]),
+			int(off[5]),
 			3,
 		),
 		Code: utils.GetSliceFromRawData(
 			tb,
-			int(getFRLGTrainerOffsets()[6]),
+			int(off[6]),
 			4,
 		),
-		Key: utils.GetSliceFromRawData(
+	}
+
+	if b.layout.HasKey {
+		info.Key = utils.GetSliceFromRawData(
 			tb,
-			int(getFRLGTrainerOffsets()[7]),
+			int(b.layout.KeyOffset),
 			4,
-		),
+		)
 	}
+
+	return info
 }
 
 func (b *SaveBlock) GetRawTeamAndItems() RawTeamAndItems {
 	ti := b.sections[1]
+	off := b.layout.TeamAndItems
 
 	return RawTeamAndItems{
 		Offset: ti.offset,
 
 		TeamSize: utils.GetSliceFromRawData(
 			ti.data,
-			int(getFRLGTeamsAndItemsOffsets()[0]),
+			int(off[0]),
 			4,
 		),
 
 		MonList: utils.GetSliceFromRawData(
 			ti.data,
-			int(getFRLGTeamsAndItemsOffsets()[1]),
+			int(off[1]),
 			600,
 		),
 
 		Money: utils.GetSliceFromRawData(
 			ti.data,
-			int(getFRLGTeamsAndItemsOffsets()[2]),
+			int(off[2]),
 			4,
 		),
 
 		Coins: utils.GetSliceFromRawData(
 			ti.data,
-			int(getFRLGTeamsAndItemsOffsets()[3]),
+			int(off[3]),
 			2,
 		),
 
 		PCItems: utils.GetSliceFromRawData(
 			ti.data,
-			int(getFRLGTeamsAndItemsOffsets()[4]),
-			120,
+			int(off[4]),
+			b.layout.PocketByteSize(0),
 		),
 
 		Items: utils.GetSliceFromRawData(
 			ti.data,
-			int(getFRLGTeamsAndItemsOffsets()[5]),
-			168,
+			int(off[5]),
+			b.layout.PocketByteSize(1),
 		),
 
 		KeyItems: utils.GetSliceFromRawData(
 			ti.data,
-			int(getFRLGTeamsAndItemsOffsets()[6]),
-			120,
+			int(off[6]),
+			b.layout.PocketByteSize(2),
 		),
 
 		Balls: utils.GetSliceFromRawData(
 			ti.data,
-			int(getFRLGTeamsAndItemsOffsets()[7]),
-			52,
+			int(off[7]),
+			b.layout.PocketByteSize(3),
 		),
 
 		TMCase: utils.GetSliceFromRawData(
 			ti.data,
-			int(getFRLGTeamsAndItemsOffsets()[8]),
-			232,
+			int(off[8]),
+			b.layout.PocketByteSize(4),
 		),
 
 		Berries: utils.GetSliceFromRawData(
 			ti.data,
-			int(getFRLGTeamsAndItemsOffsets()[7]),
-			52,
+			int(off[9]),
+			b.layout.PocketByteSize(5),
 		),
 	}
 }
 
+func (t RawTeamAndItems) GetTeamSize() int {
+	size := int(binary.LittleEndian.Uint32(t.TeamSize))
+	if size > PartyMax {
+		return PartyMax
+	}
+	return size
+}
+
+func (t RawTeamAndItems) GetPartyMonData(slot int) RawMonData {
+	if slot < 0 || slot >= PartyMax {
+		return nil
+	}
+	return utils.GetSliceFromRawData(t.MonList, slot*int(PkSize), int(PkSize))
+}
+
 func (b *SaveBlock) GetRawBoxData() RawBoxDataTotal {
 	var bdt RawBoxDataTotal
 	boxData := b.sections[5:]
@@ -391,7 +510,7 @@ func (b *SaveBlock) GetRawBoxData() RawBoxDataTotal {
 		if i == 0 {
 			buf.Data = utils.GetSliceFromRawData(boxData[i].data, 0x4, 0xF7C)
 		} else if i == 8 {
-			buf.Data = utils.GetSliceFromRawData(boxData[i].data, 0x4, 0x7D0)
+			buf.Data = utils.GetSliceFromRawData(boxData[i].data, 0x0, 0x7D0)
 		} else {
 			buf.Data = utils.GetSliceFromRawData(boxData[i].data, 0x0, 0xF80)
 		}
@@ -399,10 +518,127 @@ func (b *SaveBlock) GetRawBoxData() RawBoxDataTotal {
 	}
 
 	bdt.BoxNames = utils.GetSliceFromRawData(boxData[8].data, 0x744, 0x7E)
-	bdt.WallPapers = utils.GetSliceFromRawData(boxData[8].data, 0x87D, 0xE)
+	bdt.WallPapers = utils.GetSliceFromRawData(boxData[8].data, 0x7C2, 0xE)
 	return bdt
 }
 
+// The PC buffer is one contiguous struct that the game splits across
+// sections 5-13, so a single box mon can straddle two sections
+func pcBufferLocation(off uint) (int, uint) {
+	return pcBufferSection + int(off/pcBufferDataSize), off % pcBufferDataSize
+}
+
+func (b *SaveBlock) writePCBuffer(off uint, data []byte) {
+	for i, v := range data {
+		sec, o := pcBufferLocation(off + uint(i))
+		b.sections[sec].data[o] = v
+		b.sections[sec].dirty = true
+	}
+}
+
+// SetBoxMon writes the first 0x50 bytes of an encrypted mon into the PC box slot
+func (b *SaveBlock) SetBoxMon(box int, slot int, data RawMonData) error {
+	if box < 0 || box >= PCBoxCount || slot < 0 || slot >= PCBoxMonCount {
+		return utils.ErrOutOfRange
+	}
+
+	if uint(len(data)) < BoxPkSize {
+		return utils.ErrPKIncorrectFileSize
+	}
+
+	off := 0x4 + uint(box*PCBoxMonCount+slot)*BoxPkSize
+	b.writePCBuffer(off, data[:BoxPkSize])
+	return nil
+}
+
+// SetBoxName writes the encoded name of a PC box, names sit right after
+// the last box's mons
+func (b *SaveBlock) SetBoxName(box int, name []byte) error {
+	if box < 0 || box >= PCBoxCount || uint(len(name)) != BoxNameSize {
+		return utils.ErrOutOfRange
+	}
+
+	off := 0x4 + PCBoxCount*PCBoxMonCount*BoxPkSize + uint(box)*BoxNameSize
+	b.writePCBuffer(off, name)
+	return nil
+}
+
+// SetPartyMon writes a full 100 byte encrypted mon into the party slot.
+// Writing one past the end of the party grows the team size by one
+func (b *SaveBlock) SetPartyMon(slot int, data RawMonData) error {
+	if uint(len(data)) != PkSize {
+		return utils.ErrPKIncorrectFileSize
+	}
+
+	sec := &b.sections[1]
+	sizeOffset := int(b.layout.TeamAndItems[0])
+	size := int(binary.LittleEndian.Uint32(utils.GetSliceFromRawData(sec.data, sizeOffset, 4)))
+
+	if slot < 0 || slot >= PartyMax || slot > size {
+		return utils.ErrOutOfRange
+	}
+
+	if slot == size {
+		binary.LittleEndian.PutUint32(sec.data[sizeOffset:sizeOffset+4], uint32(size+1))
+	}
+
+	off := int(b.layout.TeamAndItems[1]) + slot*int(PkSize)
+	copy(sec.data[off:off+int(PkSize)], data)
+	sec.dirty = true
+
+	return nil
+}
+
+func (b *SaveBlock) GetSaveIndex() uint32 {
+	return binary.LittleEndian.Uint32(b.sections[0].saveIndex)
+}
+
+// WriteSaveBlock writes every section of the block over the backup save
+// block with a save index one past the newest, so the game loads it as
+// the most recent save. An active block moves to the backup slot the way
+// the game saves, a backup block is written in place so the newest save
+// is kept as the new backup. Only sections that were edited get their
+// checksum recomputed
+func (s *RawSaveFile) WriteSaveBlock(b *SaveBlock) error {
+	if !s.isCorrectSize {
+		return utils.ErrSaveIncorrectFileSize
+	}
+
+	dst := s.getBackupSaveBlockOffset()
+
+	// Wraps the same way the game's counter does
+	_, latest := s.getActiveSaveBlockOffsetAndSaveCount()
+	index := latest + 1
+
+	for i := range b.sections {
+		sec := &b.sections[i]
+		pos := sec.offset - b.offset
+
+		if sec.dirty {
+			binary.LittleEndian.PutUint16(
+				sec.checksum,
+				generateChecksum(sec.data, int(GetSectionSizes()[i])),
+			)
+			sec.dirty = false
+		}
+		binary.LittleEndian.PutUint32(sec.saveIndex, index)
+
+		sec.offset = dst + pos
+		copy(s.Data[sec.offset:sec.offset+SaveSectionSize], sec.data)
+	}
+
+	b.offset = dst
+	return nil
+}
+
+func (s *RawSaveFile) WriteToFile(p string) error {
+	if !s.isCorrectSize {
+		return utils.ErrSaveIncorrectFileSize
+	}
+
+	return os.WriteFile(p, s.Data, 0644)
+}
+
 func generateChecksum(data []byte, size int) uint16 {
 	var checksum uint32 = 0
 
@@ -414,20 +650,35 @@ func generateChecksum(data []byte, size int) uint16 {
 	return uint16((checksum >> 16) + (checksum & 0xFFFF))
 }
 
-func checkFileSize(p string) bool {
-	f, err := os.Stat(p)
-	checkError(err)
-	return f.Size() == int64(SaveSize)
-}
+// Reads the whole file, returning short or wrong when it isn't size bytes
+func readFileOfSize(p string, size uint, short, wrong error) ([]byte, error) {
+	f, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", utils.ErrFileNotFound, p)
+	}
 
-func checkPkSize(p string) bool {
-	f, err := os.Stat(p)
-	checkError(err)
-	return f.Size() == int64(PkSize)
-}
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case info.Size() < int64(size):
+		return nil, short
+	case info.Size() != int64(size):
+		return nil, wrong
+	}
 
-func checkError(e error) {
-	if e != nil {
-		panic(e)
+	buffer := make([]byte, size)
+	if _, err := io.ReadFull(f, buffer); err != nil {
+		return nil, err
 	}
+
+	return buffer, nil
 }
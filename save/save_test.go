@@ -0,0 +1,140 @@
+package save
+
+import (
+	"encoding/binary"
+	"math"
+	"slices"
+	"testing"
+)
+
+// Writes a block the game would accept at offset, every section in order
+// with the signature, its checksum and the save index
+func writeValidBlock(data []byte, offset uint, index uint32) {
+	footer := getSectionFooterOffsets()
+
+	for id := range SectionCount {
+		sec := data[offset+uint(id)*SaveSectionSize:][:SaveSectionSize]
+		clear(sec)
+
+		binary.LittleEndian.PutUint16(sec[footer[1]:], uint16(id))
+		binary.LittleEndian.PutUint16(sec[footer[2]:], generateChecksum(sec, int(GetSectionSizes()[id])))
+		copy(sec[footer[3]:], getSignatureSlice())
+		binary.LittleEndian.PutUint32(sec[footer[4]:], index)
+	}
+}
+
+// Leaves a block the way flash is before it's ever written
+func eraseBlock(data []byte, offset uint) {
+	copy(data[offset:offset+SectionCount*SaveSectionSize], slices.Repeat([]byte{0xFF}, SectionCount*int(SaveSectionSize)))
+}
+
+func TestActiveSaveBlock(t *testing.T) {
+	blockA, blockB := getBlockOffsets()[0], getBlockOffsets()[1]
+
+	tests := []struct {
+		name   string
+		setup  func([]byte)
+		active uint
+		index  uint32
+	}{
+		{
+			name: "saved once with block b erased",
+			setup: func(d []byte) {
+				writeValidBlock(d, blockA, 1)
+				eraseBlock(d, blockB)
+			},
+			active: blockA,
+			index:  1,
+		},
+		{
+			name: "saved once with block a erased",
+			setup: func(d []byte) {
+				eraseBlock(d, blockA)
+				writeValidBlock(d, blockB, 1)
+			},
+			active: blockB,
+			index:  1,
+		},
+		{
+			name: "newer block has a bad checksum",
+			setup: func(d []byte) {
+				writeValidBlock(d, blockA, 4)
+				writeValidBlock(d, blockB, 5)
+				d[blockB+3*SaveSectionSize] ^= 0xFF
+			},
+			active: blockA,
+			index:  4,
+		},
+		{
+			name: "both valid",
+			setup: func(d []byte) {
+				writeValidBlock(d, blockA, 4)
+				writeValidBlock(d, blockB, 5)
+			},
+			active: blockB,
+			index:  5,
+		},
+		{
+			name: "both valid with the index wrapped",
+			setup: func(d []byte) {
+				writeValidBlock(d, blockA, 0)
+				writeValidBlock(d, blockB, math.MaxUint32)
+			},
+			active: blockA,
+			index:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := RawSaveFile{Data: make([]byte, SaveSize), isCorrectSize: true}
+			tt.setup(s.Data)
+
+			active, index := s.getActiveSaveBlockOffsetAndSaveCount()
+			if active != tt.active || index != tt.index {
+				t.Fatalf("active block 0x%05X index %d, want 0x%05X index %d", active, index, tt.active, tt.index)
+			}
+
+			b, err := s.GenerateSaveBlock()
+			if err != nil {
+				t.Fatalf("GenerateSaveBlock: %v", err)
+			}
+			if b.GetOffset() != tt.active {
+				t.Fatalf("loaded block 0x%05X, want 0x%05X", b.GetOffset(), tt.active)
+			}
+		})
+	}
+}
+
+// Writing a save that only has one valid block puts the new one in the
+// erased half and keeps the old one as the backup
+func TestWriteSaveBlockOverErasedBlock(t *testing.T) {
+	blockA, blockB := getBlockOffsets()[0], getBlockOffsets()[1]
+
+	s := RawSaveFile{Data: make([]byte, SaveSize), isCorrectSize: true}
+	writeValidBlock(s.Data, blockA, 1)
+	eraseBlock(s.Data, blockB)
+
+	b, err := s.GenerateSaveBlock()
+	if err != nil {
+		t.Fatalf("GenerateSaveBlock: %v", err)
+	}
+	if err := s.WriteSaveBlock(&b); err != nil {
+		t.Fatalf("WriteSaveBlock: %v", err)
+	}
+
+	active, index := s.getActiveSaveBlockOffsetAndSaveCount()
+	if active != blockB || index != 2 {
+		t.Fatalf("active block 0x%05X index %d, want 0x%05X index 2", active, index, blockB)
+	}
+
+	r, err := s.CheckIntegrity()
+	if err != nil {
+		t.Fatalf("CheckIntegrity: %v", err)
+	}
+	for _, blk := range r.Blocks {
+		if !blk.IsValid() {
+			t.Fatalf("block %s is broken after the write", blk.Name)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package save
+
+import (
+	"bytes"
+	"encoding/binary"
+	vals "postal/game"
+	"postal/utils"
+)
+
+const (
+	TextSpeedMask   uint8 = 0b0000_0111
+	FrameMask       uint8 = 0b1111_1000
+	SoundMask       uint8 = 0b0000_0001
+	BattleStyleMask uint8 = 0b0000_0010
+	BattleSceneMask uint8 = 0b0000_0100
+)
+
+type (
+	TrainerInfo struct {
+		Game    GameVersion
+		Name    string
+		Gender  uint8
+		TID     uint16
+		SID     uint16
+		Time    PlayTime
+		Options TrainerOptions
+		Key     uint32
+	}
+
+	PlayTime struct {
+		Hours   uint16
+		Minutes uint8
+		Seconds uint8
+		Frames  uint8
+	}
+
+	TrainerOptions struct {
+		ButtonMode  uint8
+		TextSpeed   uint8
+		Frame       uint8
+		Sound       uint8
+		BattleStyle uint8
+		BattleScene uint8
+	}
+)
+
+func (b *SaveBlock) GetTrainerInfo() TrainerInfo {
+	raw := b.GetRawTrainerInfo()
+	info := raw.Decode()
+	info.Game = b.layout.Game
+	info.Key = b.GetSecurityKey()
+	return info
+}
+
+// Decode converts the raw trainer section fields. Game and Key are left
+// empty since they depend on the save layout, use SaveBlock.GetTrainerInfo
+func (r RawTrainerInfo) Decode() TrainerInfo {
+	name := r.Name
+	if i := bytes.IndexByte(name, 0xFF); i >= 0 {
+		name = name[:i]
+	}
+
+	info := TrainerInfo{
+		Name:   utils.WesternSliceToString(name),
+		Gender: r.Gender[0],
+		TID:    binary.LittleEndian.Uint16(r.TID),
+		SID:    binary.LittleEndian.Uint16(r.SID),
+		Time: PlayTime{
+			Hours:   binary.LittleEndian.Uint16(r.Time[0:2]),
+			Minutes: r.Time[2],
+			Seconds: r.Time[3],
+			Frames:  r.Time[4],
+		},
+		Options: TrainerOptions{
+			ButtonMode:  r.Options[0],
+			TextSpeed:   r.Options[1] & TextSpeedMask,
+			Frame:       (r.Options[1] & FrameMask) >> 3,
+			Sound:       r.Options[2] & SoundMask,
+			BattleStyle: (r.Options[2] & BattleStyleMask) >> 1,
+			BattleScene: (r.Options[2] & BattleSceneMask) >> 2,
+		},
+	}
+
+	return info
+}
+
+func (t TrainerInfo) GetOTID() uint32 {
+	return uint32(t.SID)<<16 | uint32(t.TID)
+}
+
+// IsOriginalTrainer reports whether a mon with the given OTID belongs to this trainer
+func (t TrainerInfo) IsOriginalTrainer(otid uint32) bool {
+	return t.GetOTID() == otid
+}
+
+func (t TrainerInfo) GetGender() string {
+	if t.Gender == 0 {
+		return "Male"
+	}
+	return "Female"
+}
+
+func (o TrainerOptions) GetButtonMode() string {
+	if s, ok := vals.ButtonOption[o.ButtonMode]; ok {
+		return s
+	}
+	return "Unknown"
+}
+
+func (o TrainerOptions) GetTextSpeed() string {
+	if s, ok := vals.TextSpeed[o.TextSpeed]; ok {
+		return s
+	}
+	return "Unknown"
+}
+
+func (o TrainerOptions) GetSound() string {
+	return vals.AudioSetting[o.Sound]
+}
+
+func (o TrainerOptions) GetBattleStyle() string {
+	if o.BattleStyle == 0 {
+		return "SHIFT"
+	}
+	return "SET"
+}
+
+func (o TrainerOptions) GetBattleScene() string {
+	if o.BattleScene == 0 {
+		return "ON"
+	}
+	return "OFF"
+}
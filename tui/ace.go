@@ -0,0 +1,166 @@
+package tui
+
+import (
+	"fmt"
+	"postal/ace"
+	"postal/boxes"
+	"postal/utils"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Disassembly rows shown at once, pgup and pgdown scroll the rest
+const aceListHeight = 20
+
+var aceTerminatorStyle = lipgloss.NewStyle().Foreground(LightPink)
+
+type aceEditor struct {
+	pc      *boxes.PCStorage
+	arm     bool
+	scroll  int
+	editor  textarea.Model
+	payload ace.Payload
+	err     error
+	status  string
+	help    help.Model
+	keys    *AceKeyMap
+}
+
+func NewAceEditor(pc *boxes.PCStorage) aceEditor {
+	ta := textarea.New()
+	ta.Placeholder = "ldmia r4!, {r0, r2, r5, r7}"
+	ta.ShowLineNumbers = true
+	ta.SetWidth(44)
+	ta.SetHeight(aceListHeight / 2)
+	ta.Focus()
+
+	m := aceEditor{
+		pc:     pc,
+		editor: ta,
+		help:   help.New(),
+		keys:   &AceKeys,
+	}
+	m.assemble()
+	return m
+}
+
+func (m *aceEditor) assemble() {
+	m.payload, m.err = ace.Assemble(m.editor.Value(), 0)
+}
+
+func (m aceEditor) Init() tea.Cmd { return textarea.Blink }
+
+func (m aceEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if k, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(k, m.keys.Help):
+			m.help.ShowAll = !m.help.ShowAll
+			return m, nil
+
+		case key.Matches(k, m.keys.Mode):
+			m.arm = !m.arm
+			m.scroll = 0
+			return m, nil
+
+		case key.Matches(k, m.keys.Next):
+			m.scroll += aceListHeight
+			return m, nil
+
+		case key.Matches(k, m.keys.Prev):
+			m.scroll = max(m.scroll-aceListHeight, 0)
+			return m, nil
+
+		case key.Matches(k, m.keys.Apply):
+			return m, m.apply()
+		}
+	}
+
+	var cmd tea.Cmd
+	before := m.editor.Value()
+	m.editor, cmd = m.editor.Update(msg)
+
+	if m.editor.Value() != before {
+		m.status = ""
+		m.assemble()
+	}
+	return m, cmd
+}
+
+// Copies the names the code reaches into the storage, the rest keep
+// what they're called. Nothing is written until the save is
+func (m *aceEditor) apply() tea.Cmd {
+	if m.err != nil || m.payload.Used() == 0 {
+		return nil
+	}
+
+	for i := range m.payload.Used() {
+		if err := m.pc.SetBoxNameData(i, m.payload.Name(i)); err != nil {
+			m.err = err
+			return nil
+		}
+	}
+
+	m.status = fmt.Sprintf("Code copied into %d box names", m.payload.Used())
+	return func() tea.Msg { return storageChangedMsg{} }
+}
+
+func formatAceLine(l ace.Line) string {
+	s := fmt.Sprintf("%2d %02X %-8X %s", l.Box()+1, l.Offset, l.Bytes, l.Text)
+	if l.HasTerminator() {
+		return aceTerminatorStyle.Render(s)
+	}
+	return s
+}
+
+func (m aceEditor) makeDisassemblyView() string {
+	mode := "THUMB"
+	if m.arm {
+		mode = "ARM"
+	}
+
+	lines := ace.Disassemble(m.pc.BoxNameData(), 0, m.arm)
+	start := min(m.scroll, max(len(lines)-aceListHeight, 0))
+
+	rows := []string{RibbonSumStyle.Render("Box names as " + mode), " "}
+	for _, l := range lines[start:min(start+aceListHeight, len(lines))] {
+		rows = append(rows, formatAceLine(l))
+	}
+
+	return MenuStyle.Align(lipgloss.Left).Width(68).Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+func (m aceEditor) makeAssemblerView() string {
+	rows := []string{RibbonSumStyle.Render("THUMB assembler"), " ", m.editor.View(), " "}
+
+	if m.err != nil {
+		rows = append(rows, RibbonXMarkEnumStyle.Width(46).Render(m.err.Error()))
+	} else {
+		for _, l := range m.payload.Lines {
+			rows = append(rows, formatAceLine(l))
+		}
+
+		rows = append(rows, " ")
+		for i := range m.payload.Used() {
+			name := m.payload.Name(i)
+			rows = append(rows, fmt.Sprintf("%2d %X %s", i+1, name, utils.DecodeText(name, utils.LangEnglish)))
+		}
+	}
+
+	if m.status != "" {
+		rows = append(rows, " ", KeyPair.Render(m.status))
+	}
+
+	return MenuStyle.Align(lipgloss.Left).Width(52).Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+func (m aceEditor) View() string {
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		lipgloss.JoinHorizontal(lipgloss.Top, m.makeDisassemblyView(), m.makeAssemblerView()),
+		m.help.View(m.keys),
+	)
+}
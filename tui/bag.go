@@ -0,0 +1,270 @@
+package tui
+
+import (
+	"fmt"
+	vals "postal/game"
+	"postal/save"
+	"postal/utils"
+	"strconv"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	BagFieldNames = []string{"Item", "Quantity", "Money", "Coins"}
+)
+
+var bagCols = []table.Column{
+	{Title: "Slot", Width: 5},
+	{Title: "Item", Width: 16},
+	{Title: "ID", Width: 6},
+	{Title: "Qty", Width: 5},
+}
+
+type bagEditor struct {
+	focusIndex int
+	pocket     int
+	block      *save.SaveBlock
+	bag        save.Bag
+	table      table.Model
+	inputs     []textinput.Model
+	help       help.Model
+	keys       *BagKeyMap
+	err        error
+}
+
+func NewBagEditor(b *save.SaveBlock) bagEditor {
+	bag := b.GetBag()
+
+	return bagEditor{
+		block:  b,
+		bag:    bag,
+		table:  makeTableFromPocket(bag.Pockets[0]),
+		inputs: makeEditorTextModels(BagFieldNames),
+		help:   help.New(),
+		keys:   &BagKeys,
+	}
+}
+
+func (m bagEditor) Init() tea.Cmd { return textinput.Blink }
+
+func (m bagEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Help):
+			m.help.ShowAll = !m.help.ShowAll
+
+		case key.Matches(msg, m.keys.Tab),
+			key.Matches(msg, m.keys.ShTab):
+
+			n := len(m.bag.Pockets)
+			if key.Matches(msg, m.keys.Tab) {
+				m.pocket = (m.pocket + 1) % n
+			} else {
+				m.pocket = (m.pocket + n - 1) % n
+			}
+
+			m.table = makeTableFromPocket(m.bag.Pockets[m.pocket])
+			return m, nil
+
+		case key.Matches(msg, m.keys.Left),
+			key.Matches(msg, m.keys.Right):
+
+			if key.Matches(msg, m.keys.Right) {
+				m.focusIndex++
+			} else {
+				m.focusIndex--
+			}
+
+			if m.focusIndex >= len(m.inputs) {
+				m.focusIndex = 0
+			} else if m.focusIndex < 0 {
+				m.focusIndex = len(m.inputs) - 1
+			}
+
+			cmds := make([]tea.Cmd, len(m.inputs))
+			for i := range m.inputs {
+				if i == m.focusIndex {
+					cmds[i] = m.inputs[i].Focus()
+					m.inputs[i].PromptStyle = FocusedStyle
+					m.inputs[i].TextStyle = FocusedText
+					continue
+				}
+				m.inputs[i].Blur()
+				m.inputs[i].PromptStyle = lipgloss.NewStyle()
+				m.inputs[i].TextStyle = lipgloss.NewStyle().Foreground(SubText)
+			}
+			return m, tea.Batch(cmds...)
+
+		case key.Matches(msg, m.keys.Enter):
+			m.err = m.CommitEdits()
+			m.inputs[m.focusIndex].Reset()
+			return m, nil
+
+		case key.Matches(msg, m.keys.Up),
+			key.Matches(msg, m.keys.Down):
+
+			m.table, cmd = m.table.Update(msg)
+			return m, cmd
+		}
+	}
+
+	cmds := make([]tea.Cmd, len(m.inputs))
+	for i := range m.inputs {
+		m.inputs[i], cmds[i] = m.inputs[i].Update(msg)
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// CommitEdits writes the focused field into the save block. Item and quantity
+// apply to the selected slot of the current pocket
+func (m *bagEditor) CommitEdits() error {
+	v := m.inputs[m.focusIndex].Value()
+
+	switch m.focusIndex {
+	case 0:
+		id, err := parseItemInput(v)
+		if err != nil {
+			return err
+		}
+		m.bag.Pockets[m.pocket].Slots[m.table.Cursor()].ID = id
+
+	case 1:
+		n, err := strconv.ParseUint(v, 10, 16)
+		if err != nil {
+			return err
+		}
+		m.bag.Pockets[m.pocket].Slots[m.table.Cursor()].Quantity = uint16(n)
+
+	case 2:
+		n, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return err
+		}
+		m.bag.Money = uint32(n)
+		m.block.SetMoney(m.bag.Money)
+		return nil
+
+	case 3:
+		n, err := strconv.ParseUint(v, 10, 16)
+		if err != nil {
+			return err
+		}
+		m.bag.Coins = uint16(n)
+		m.block.SetCoins(m.bag.Coins)
+		return nil
+	}
+
+	p := m.bag.Pockets[m.pocket]
+	cursor := m.table.Cursor()
+	m.table.SetRows(pocketToTableRows(p))
+	m.table.SetCursor(cursor)
+
+	return m.block.SetPocket(m.pocket, p.Slots)
+}
+
+// Items can be entered by name or by their index (0x prefix for hex)
+func parseItemInput(s string) (uint16, error) {
+	if item, err := vals.ItemLookup(utils.SanitizeSearch(s)); err == nil {
+		return uint16(item), nil
+	}
+
+	n, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return 0, utils.ErrOutOfRange
+	}
+
+	return uint16(n), nil
+}
+
+func (m bagEditor) View() string {
+	p := m.bag.Pockets[m.pocket]
+
+	used := 0
+	for _, v := range p.Slots {
+		if v.ID != 0 {
+			used++
+		}
+	}
+
+	title := fmt.Sprintf(
+		" %s (%d/%d)   Money: $%d   Coins: %d",
+		p.Name, used, len(p.Slots), m.bag.Money, m.bag.Coins,
+	)
+
+	editor := make([]string, len(m.inputs))
+	for i := range m.inputs {
+		editor[i] = WordEntryStyle.UnsetPadding().Render(m.inputs[i].View())
+	}
+
+	status := " "
+	if m.err != nil {
+		status = RibbonXMarkEnumStyle.Render(m.err.Error())
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		baseStyle.Render(m.table.View()),
+		MailMenuStyle.Render(lipgloss.JoinHorizontal(lipgloss.Center, editor...)),
+		status,
+		m.help.View(m.keys),
+	)
+}
+
+func itemName(id uint16) string {
+	if n, ok := vals.ItemName(id); ok {
+		return n
+	}
+	return "Unknown"
+}
+
+func pocketToTableRows(p save.Pocket) []table.Row {
+	rows := make([]table.Row, len(p.Slots))
+
+	for i, v := range p.Slots {
+		rows[i] = table.Row{
+			fmt.Sprintf("%d", i+1),
+			itemName(v.ID),
+			fmt.Sprintf("%04X", v.ID),
+			fmt.Sprintf("%d", v.Quantity),
+		}
+	}
+
+	return rows
+}
+
+func makeTableFromPocket(p save.Pocket) table.Model {
+	t := table.New(
+		table.WithColumns(bagCols),
+		table.WithRows(pocketToTableRows(p)),
+		table.WithFocused(true),
+		table.WithHeight(16),
+	)
+
+	s := table.DefaultStyles()
+
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		BorderBottom(true).
+		Bold(false)
+
+	s.Selected = s.Selected.
+		Foreground(RegularText).
+		Background(DarkPurple).
+		Bold(false)
+
+	t.SetStyles(s)
+
+	return t
+}
@@ -0,0 +1,274 @@
+package tui
+
+import (
+	"fmt"
+	"postal/boxes"
+	"postal/pokemon"
+	"postal/utils"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Same order as the mail words, PIDHI, PIDLO, SID, TID
+var batchWordNames = []string{"PIDHI", "PIDLO", "SID", "TID"}
+
+var batchCols = []table.Column{
+	{Title: "Slot", Width: 5},
+	{Title: "Sel", Width: 4},
+	{Title: "Species", Width: 12},
+	{Title: "Item", Width: 12},
+	{Title: "Moves", Width: 48},
+	{Title: "Bad Egg", Width: 8},
+}
+
+// Loads a mail word set into the mail editor
+type mailWordsMsg struct {
+	words [4]uint16
+}
+
+type batchMail struct {
+	pc       *boxes.PCStorage
+	index    int
+	inputs   []textinput.Model
+	focus    int
+	words    [4]uint16
+	selected map[int]bool
+	results  []boxes.MailResult
+	table    table.Model
+	help     help.Model
+	keys     *BatchKeyMap
+	err      error
+}
+
+func NewBatchMail(pc *boxes.PCStorage, index int) batchMail {
+	m := batchMail{
+		pc:       pc,
+		index:    index,
+		inputs:   make([]textinput.Model, len(batchWordNames)),
+		selected: make(map[int]bool),
+		help:     help.New(),
+		keys:     &BatchKeys,
+	}
+
+	for i := range m.inputs {
+		ti := textinput.New()
+		ti.Prompt = fmt.Sprintf("%-6s", batchWordNames[i])
+		ti.Placeholder = WordEntryZero
+		ti.Cursor.Style = lipgloss.NewStyle().Foreground(LightPink)
+		ti.CharLimit = 32
+		ti.Width = 12
+		m.inputs[i] = ti
+	}
+	m.inputs[0].Focus()
+	m.inputs[0].PromptStyle = FocusedStyle
+
+	m.table = makeTableFromBox(boxes.RWPCBox{})
+	m.table.SetColumns(batchCols)
+	m.table.SetHeight(boxes.BoxMonCount / 2)
+
+	m.apply()
+	return m
+}
+
+func (m batchMail) Init() tea.Cmd { return nil }
+
+func (m batchMail) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	k, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	return m.updateKeys(k)
+}
+
+func (m batchMail) updateKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Help):
+		m.help.ShowAll = !m.help.ShowAll
+		return m, nil
+
+	case key.Matches(msg, m.keys.Tab), key.Matches(msg, m.keys.ShTab):
+		m.inputs[m.focus].Blur()
+		m.inputs[m.focus].PromptStyle = lipgloss.NewStyle()
+
+		n := len(m.inputs)
+		if key.Matches(msg, m.keys.Tab) {
+			m.focus = (m.focus + 1) % n
+		} else {
+			m.focus = (m.focus + n - 1) % n
+		}
+
+		m.inputs[m.focus].PromptStyle = FocusedStyle
+		return m, m.inputs[m.focus].Focus()
+
+	case key.Matches(msg, m.keys.Up):
+		m.table.MoveUp(1)
+		return m, nil
+
+	case key.Matches(msg, m.keys.Down):
+		m.table.MoveDown(1)
+		return m, nil
+
+	case key.Matches(msg, m.keys.Next), key.Matches(msg, m.keys.Prev):
+		if key.Matches(msg, m.keys.Next) {
+			m.index = (m.index + 1) % boxes.BoxCount
+		} else {
+			m.index = (m.index + boxes.BoxCount - 1) % boxes.BoxCount
+		}
+		clear(m.selected)
+		m.apply()
+		m.table.GotoTop()
+		return m, nil
+
+	case key.Matches(msg, m.keys.Select):
+		if r, ok := m.selectedResult(); ok {
+			m.selected[r.Slot] = !m.selected[r.Slot]
+			m.apply()
+		}
+		return m, nil
+
+	case key.Matches(msg, m.keys.Clear):
+		clear(m.selected)
+		m.apply()
+		return m, nil
+
+	case key.Matches(msg, m.keys.Load):
+		r, ok := m.selectedResult()
+		if !ok {
+			return m, nil
+		}
+
+		words := m.words
+		return m, tea.Sequence(
+			returnToMain(r.Before, monSlot{box: m.index, index: r.Slot}),
+			func() tea.Msg { return mailWordsMsg{words: words} },
+		)
+	}
+
+	var cmd tea.Cmd
+	m.inputs[m.focus], cmd = m.inputs[m.focus].Update(msg)
+	m.apply()
+	return m, cmd
+}
+
+func (m *batchMail) selectedResult() (boxes.MailResult, bool) {
+	i := m.table.Cursor()
+	if i < 0 || i >= len(m.results) {
+		return boxes.MailResult{}, false
+	}
+	return m.results[i], true
+}
+
+func (m *batchMail) selectedSlots() []int {
+	var slots []int
+	for i, ok := range m.selected {
+		if ok {
+			slots = append(slots, i)
+		}
+	}
+	slices.Sort(slots)
+	return slots
+}
+
+// Reruns the words over the box, words that don't parse count as empty
+// and keep what each mon already has
+func (m *batchMail) apply() {
+	m.err = nil
+	for i := range m.inputs {
+		s := strings.TrimSpace(m.inputs[i].Value())
+		if s == "" {
+			m.words[i] = 0
+			continue
+		}
+
+		w, err := pokemon.ParseMailWord(s)
+		if err != nil {
+			m.err = fmt.Errorf("%s: %w", batchWordNames[i], err)
+		}
+		m.words[i] = w
+	}
+
+	m.results, _ = m.pc.Boxes[m.index].ApplyMail(m.words, m.selectedSlots())
+
+	rows := make([]table.Row, len(m.results))
+	for i, r := range m.results {
+		rows[i] = m.makeResultRow(r)
+	}
+	m.table.SetRows(rows)
+}
+
+func (m *batchMail) makeResultRow(r boxes.MailResult) table.Row {
+	_, species := r.After.GetSpecies()
+	_, item := r.After.GetHeldItem()
+
+	var moves []string
+	for _, mv := range r.After.GetMoveStrings() {
+		if mv != "" {
+			moves = append(moves, mv)
+		}
+	}
+
+	sel := ""
+	if m.selected[r.Slot] {
+		sel = CheckMark
+	}
+
+	egg := CheckMark
+	if r.Check.WillBeBadEgg() {
+		egg = XMark
+	}
+
+	return table.Row{strconv.Itoa(r.Slot + 1), sel, species, item, strings.Join(moves, ", "), egg}
+}
+
+func (m batchMail) makeWordView() string {
+	views := make([]string, len(m.inputs))
+	for i := range m.inputs {
+		views[i] = m.inputs[i].View()
+	}
+
+	status := fmt.Sprintf("%04X %04X %04X %04X", m.words[0], m.words[1], m.words[2], m.words[3])
+	if m.err != nil {
+		status = RibbonXMarkEnumStyle.Render(m.err.Error())
+	}
+
+	return WordEntryStyle.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		lipgloss.JoinHorizontal(lipgloss.Top, views[0], "  ", views[1]),
+		lipgloss.JoinHorizontal(lipgloss.Top, views[2], "  ", views[3]),
+		" ",
+		status,
+	))
+}
+
+func (m batchMail) View() string {
+	target := "every mon"
+	if n := len(m.selectedSlots()); n > 0 {
+		target = fmt.Sprintf("%d selected", n)
+	}
+
+	eggs := 0
+	for _, r := range m.results {
+		if r.Check.WillBeBadEgg() {
+			eggs++
+		}
+	}
+
+	s := fmt.Sprintf(" Box #%d %s, %s, %d of %d Bad Eggs", m.index+1,
+		utils.CleanText(m.pc.Boxes[m.index].Name), target, eggs, len(m.results))
+
+	o := lipgloss.JoinVertical(lipgloss.Left, s, m.makeWordView(), baseStyle.Render(m.table.View()))
+
+	if r, ok := m.selectedResult(); ok {
+		o = lipgloss.JoinHorizontal(lipgloss.Center, o, generateMonDiffViewOrder(&r.After, &r.Before))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Center, o, m.help.View(m.keys))
+}
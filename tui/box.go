@@ -4,12 +4,13 @@ import (
 	"fmt"
 	"postal/boxes"
 	"postal/pokemon"
-	"postal/save"
+	"postal/utils"
 	"strconv"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -19,11 +20,26 @@ var baseStyle = lipgloss.NewStyle().
 	BorderForeground(lipgloss.Color("240"))
 
 type returnMsg struct {
-	pk pokemon.PStructure
+	pk   pokemon.PStructure
+	slot monSlot
 }
 
-func returnToMain(p pokemon.PStructure) tea.Cmd {
-	return func() tea.Msg { return returnMsg{pk: p} }
+// Where a mon loaded from a save came from so edits can be written back
+type monSlot struct {
+	box   int
+	index int
+	party bool
+}
+
+func (s monSlot) String() string {
+	if s.party {
+		return fmt.Sprintf("party slot %d", s.index+1)
+	}
+	return fmt.Sprintf("box %d slot %d", s.box+1, s.index+1)
+}
+
+func returnToMain(p pokemon.PStructure, s monSlot) tea.Cmd {
+	return func() tea.Msg { return returnMsg{pk: p, slot: s} }
 }
 
 type boxSelect struct {
@@ -32,10 +48,14 @@ type boxSelect struct {
 	table table.Model
 	help  help.Model
 	keys  *BoxViewKeyMap
-	data  save.RawBoxDataTotal
-	mash  boxes.PCBoxBufferMash
-	box   boxes.RWPCBox
+	pc    *boxes.PCStorage
+	box   *boxes.RWPCBox
 	pks   pokemon.PStructure
+
+	// Box name input, names take the same escapes as the name editor
+	renaming bool
+	input    textinput.Model
+	err      error
 }
 
 var cols = []table.Column{
@@ -54,7 +74,19 @@ func (m boxSelect) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.renaming {
+			return m.updateRename(msg)
+		}
+
 		switch {
+		case key.Matches(msg, m.keys.Help):
+			m.help.ShowAll = !m.help.ShowAll
+
+		case key.Matches(msg, m.keys.Rename):
+			m.renaming = true
+			m.err = nil
+			m.input.SetValue(m.box.Name)
+			return m, m.input.Focus()
 
 		case key.Matches(msg, m.keys.Tab),
 			key.Matches(msg, m.keys.ShTab):
@@ -84,7 +116,7 @@ func (m boxSelect) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, cmd
 				} else {
 					m.pks = m.box.Mons[i-1]
-					return m, returnToMain(m.pks)
+					return m, returnToMain(m.pks, monSlot{box: m.index, index: i - 1})
 				}
 			}
 		}
@@ -94,15 +126,51 @@ func (m boxSelect) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
-func (m boxSelect) View() string {
-	s := fmt.Sprintf(" Box #%d %s", m.index+1, m.name)
+func (m boxSelect) updateRename(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Rename):
+		m.renaming = false
+		m.err = nil
+		return m, nil
+
+	case key.Matches(msg, m.keys.Enter):
+		if m.err = m.pc.SetBoxName(m.index, m.input.Value()); m.err != nil {
+			return m, nil
+		}
+		m.renaming = false
+		m.name = m.box.Name
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m boxSelect) makeNameView() string {
+	s := fmt.Sprintf(" Box #%d %s", m.index+1, utils.CleanText(m.name))
+	if !m.renaming {
+		return s
+	}
+
+	status := "enter renames, " + m.keys.Rename.Help().Key + " cancels"
+	if m.err != nil {
+		status = RibbonXMarkEnumStyle.Render(m.err.Error())
+	}
 
-	// TODO: Fix this to not regen all the data on every update
-	// This will probably require a lot of fixing of the box read
-	// Code since its super jank currently
-	// This is pretty lazy but it works for now
+	var raw string
+	if b, err := boxes.EncodeBoxName(m.input.Value()); err != nil {
+		raw = RibbonXMarkEnumStyle.Render(err.Error())
+	} else {
+		raw = hexBytes(b)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, s, WordEntryStyle.Render(m.input.View()), nameRow("Bytes", raw), " "+status)
+}
+
+func (m boxSelect) View() string {
+	s := m.makeNameView()
 
-	// Holy fuck this is bad
 	if len(m.table.SelectedRow()) > 0 {
 		i, err := strconv.Atoi(m.table.SelectedRow()[0])
 		if err != nil {
@@ -129,35 +197,38 @@ end:
 func (m *boxSelect) GetPokemon() pokemon.PStructure { return m.pks }
 
 func (m *boxSelect) UpdateBox() {
-	m.box = boxes.GenerateRWPCBox(
-		m.index, m.data, m.mash.GenerateBoxMonData(m.index),
-	)
+	m.box = &m.pc.Boxes[m.index]
 }
 
 func (m *boxSelect) UpdateTable() {
 	m.name = m.box.Name
-	m.table = makeTableFromBox(m.box)
+	m.table = makeTableFromBox(*m.box)
 }
 
 func (m *boxSelect) GetHelp() string {
 	return m.help.View(m.keys)
 }
 
-func NewBoxSelect(data save.RawBoxDataTotal) boxSelect {
-	// User box one for default
-	buffer := boxes.GeneratePCBoxBufferMash(data)
-	def := boxes.GenerateRWPCBox(0, data, buffer.GenerateBoxMonData(0))
-
-	return boxSelect{
-		index: 0,
+// Boxes are decoded once into the shared storage, the view only
+// points at whichever box is selected
+func NewBoxSelect(pc *boxes.PCStorage, index int) boxSelect {
+	ti := textinput.New()
+	ti.Placeholder = "box name"
+	ti.Cursor.Style = lipgloss.NewStyle().Foreground(LightPink)
+	ti.CharLimit = 64
+	ti.Width = 36
+
+	m := boxSelect{
+		index: index,
 		help:  help.New(),
 		keys:  &BoxKeys,
-		table: makeTableFromBox(def),
-		data:  data,
-		mash:  boxes.GeneratePCBoxBufferMash(data),
-		box:   def,
-		name:  def.Name,
+		pc:    pc,
+		input: ti,
 	}
+
+	m.UpdateBox()
+	m.UpdateTable()
+	return m
 }
 
 func monToTableRow(s string, p pokemon.PStructure) table.Row {
@@ -0,0 +1,191 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"postal/pokemon"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var chainCols = []table.Column{
+	{Title: "#", Width: 3},
+	{Title: "Step", Width: 5},
+	{Title: "PIDHI", Width: 10},
+	{Title: "PIDLO", Width: 10},
+	{Title: "SID", Width: 10},
+	{Title: "TID", Width: 10},
+	{Title: "Result", Width: 12},
+	{Title: "Egg", Width: 3},
+}
+
+type mailChain struct {
+	chain  pokemon.MailChain
+	states []pokemon.ChainState
+	table  table.Model
+	err    error
+}
+
+func newMailChain() mailChain {
+	t := table.New(
+		table.WithColumns(chainCols),
+		table.WithFocused(true),
+		table.WithHeight(8),
+	)
+
+	return mailChain{table: t}
+}
+
+// Refresh replays the chain on top of base, the base mon can change
+// under the chain when it's swapped or reloaded
+func (c *mailChain) Refresh(base pokemon.PStructure) {
+	c.chain.Base = base
+	c.states, c.err = c.chain.Run()
+
+	rows := make([]table.Row, len(c.states))
+	for i, st := range c.states {
+		s := c.chain.Steps[i]
+		_, species := st.Mon.GetSpecies()
+
+		words := []string{blank, blank, blank, blank}
+		if s.Kind == pokemon.ChainMail {
+			for j, w := range s.Words {
+				words[j] = mailWordName(w)
+			}
+		}
+
+		egg := CheckMark
+		if st.Check.WillBeBadEgg() {
+			egg = XMark
+		}
+
+		rows[i] = table.Row{
+			fmt.Sprintf("%d", i+1),
+			pokemon.ChainStepNames[s.Kind],
+			words[0], words[1], words[2], words[3],
+			species,
+			egg,
+		}
+	}
+
+	c.table.SetRows(rows)
+	if c.table.Cursor() >= len(rows) {
+		c.table.SetCursor(len(rows) - 1)
+	}
+}
+
+func (c *mailChain) Selected() (int, bool) {
+	i := c.table.Cursor()
+	return i, i >= 0 && i < len(c.states)
+}
+
+// The mon right before step i, so "keep" words follow the chain
+func (c *mailChain) stateBefore(i int) pokemon.PStructure {
+	if i <= 0 || i > len(c.states) {
+		return c.chain.Base
+	}
+	return c.states[i-1].Mon
+}
+
+func (c *mailChain) View() string {
+	status := "No steps, enter adds the current words"
+	switch n := len(c.states); {
+	case c.err != nil:
+		status = RibbonXMarkEnumStyle.Render(c.err.Error())
+	case n > 0:
+		status = fmt.Sprintf("%d steps", n)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Center, status, c.table.View())
+}
+
+func (m *MailEditor) mailStepFrom(p *pokemon.PStructure) pokemon.ChainStep {
+	return pokemon.NewMailStep(m.generatePIDOTValuesFrom(p))
+}
+
+// Results always reflect the whole chain once it has any steps
+func (m *MailEditor) refreshChain() {
+	m.chain.Refresh(m.pks)
+
+	if len(m.chain.states) > 0 {
+		r, err := m.chain.chain.Result()
+		if m.chain.err = err; err != nil {
+			return
+		}
+
+		m.pksNew = r.Mon
+		m.badEgg = r.Check
+	}
+}
+
+func (m *MailEditor) AddChainStep(kind int) {
+	prev := m.chain.stateBefore(len(m.chain.states))
+
+	s := pokemon.ChainStep{Kind: kind}
+	if kind == pokemon.ChainMail {
+		s = m.mailStepFrom(&prev)
+	}
+
+	m.chain.chain.Add(s)
+	m.refreshChain()
+	m.chain.table.SetCursor(len(m.chain.states) - 1)
+}
+
+func (m *MailEditor) UpdateChainStep() error {
+	i, ok := m.chain.Selected()
+	if !ok {
+		return nil
+	}
+
+	prev := m.chain.stateBefore(i)
+	if err := m.chain.chain.Set(i, m.mailStepFrom(&prev)); err != nil {
+		return err
+	}
+
+	m.refreshChain()
+	return nil
+}
+
+func (m *MailEditor) RemoveChainStep() error {
+	i, ok := m.chain.Selected()
+	if !ok {
+		return nil
+	}
+
+	if err := m.chain.chain.Remove(i); err != nil {
+		return err
+	}
+
+	m.refreshChain()
+	return nil
+}
+
+// LoadChainStep puts the words of the selected step back in the inputs
+func (m *MailEditor) LoadChainStep() {
+	i, ok := m.chain.Selected()
+	if !ok || m.chain.chain.Steps[i].Kind != pokemon.ChainMail {
+		return
+	}
+
+	s := m.chain.chain.Steps[i]
+	m.LoadSolution(pokemon.MailSolution{Words: s.Words, PID: s.PID(), OTID: s.OTID()})
+	m.refreshChain()
+}
+
+func (m *MailEditor) ExportChain() (string, error) {
+	d, err := m.chain.chain.ToDocument()
+	if err != nil {
+		return "", err
+	}
+
+	b, err := d.ToJSON()
+	if err != nil {
+		return "", err
+	}
+
+	sp, n := m.pks.GetSpecies()
+	f := fmt.Sprintf("%03d-%s-%08X-chain.json", sp, n, m.pks.PID)
+
+	return f, os.WriteFile(f, b, 0644)
+}
@@ -0,0 +1,158 @@
+package tui
+
+import (
+	"fmt"
+	"postal/save"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	IntegrityColumns = []string{"Offset", "ID", "Signature", "Stored", "Computed", "Index"}
+	integrityWidths  = []int{8, 6, 12, 8, 10, 8}
+)
+
+var (
+	integrityHeader   = lipgloss.NewStyle().Foreground(DarkerPink).Bold(true)
+	integrityBlock    = MenuStyle.BorderForeground(DarkerPink)
+	integritySelected = MenuStyle.BorderForeground(LightGreenish)
+)
+
+// Passed as the loaded offset when neither block could be read
+const noBlockLoaded = ^uint(0)
+
+// Asks the main model to reload its boxes from the other save block
+type loadBlockMsg struct {
+	backup bool
+}
+
+type integrityPanel struct {
+	report   save.IntegrityReport
+	loaded   uint
+	selected int
+	help     help.Model
+	keys     *BoxViewKeyMap
+}
+
+func NewIntegrityPanel(r save.IntegrityReport, loaded uint) integrityPanel {
+	m := integrityPanel{
+		report: r,
+		loaded: loaded,
+		help:   help.New(),
+		keys:   &CheckKeys,
+	}
+
+	for i, b := range r.Blocks {
+		if b.Offset == loaded {
+			m.selected = i
+		}
+	}
+
+	return m
+}
+
+func (m integrityPanel) Init() tea.Cmd { return nil }
+
+func (m integrityPanel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Help):
+			m.help.ShowAll = !m.help.ShowAll
+
+		case key.Matches(msg, m.keys.Tab), key.Matches(msg, m.keys.ShTab):
+			m.selected = (m.selected + 1) % len(m.report.Blocks)
+
+		case key.Matches(msg, m.keys.Enter):
+			backup := !m.report.Blocks[m.selected].Active
+			return m, func() tea.Msg { return loadBlockMsg{backup: backup} }
+		}
+	}
+	return m, nil
+}
+
+func integrityMark(ok bool) string {
+	if ok {
+		return RibbonCheckEnumStyle.Render(CheckMark)
+	}
+	return RibbonXMarkEnumStyle.Render(XMark)
+}
+
+func makeIntegrityRow(style lipgloss.Style, cells ...string) string {
+	for i := range cells {
+		cells[i] = style.Width(integrityWidths[i]).Render(cells[i])
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, cells...)
+}
+
+func (m integrityPanel) makeBlockView(i int) string {
+	b := &m.report.Blocks[i]
+	state := "backup"
+	if b.Active {
+		state = "active"
+	}
+	if b.Offset == m.loaded {
+		state += ", loaded"
+	}
+
+	rows := []string{
+		RibbonSumStyle.Render(fmt.Sprintf("Block %s (%s) %s", b.Name, state, integrityMark(b.IsValid()))),
+		" ",
+		makeIntegrityRow(integrityHeader, IntegrityColumns...),
+	}
+
+	for _, sec := range b.Sections {
+		id := fmt.Sprintf("%d", sec.ID)
+		if !sec.IDValid() {
+			id = integrityMark(false) + " " + id
+		}
+
+		rows = append(rows, makeIntegrityRow(
+			lipgloss.NewStyle(),
+			fmt.Sprintf("%05X", sec.Offset),
+			id,
+			fmt.Sprintf("%08X %s", sec.Signature, integrityMark(sec.SignatureValid)),
+			fmt.Sprintf("%04X", sec.StoredChecksum),
+			fmt.Sprintf("%04X %s", sec.ComputedChecksum, integrityMark(sec.ChecksumValid())),
+			fmt.Sprintf("%d", sec.SaveIndex),
+		))
+	}
+
+	rows = append(rows, " ", idList("Missing", b.Missing), idList("Duplicated", b.Duplicated))
+
+	style := integrityBlock
+	if i == m.selected {
+		style = integritySelected
+	}
+
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+func idList(name string, ids []uint16) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = fmt.Sprintf("%d", id)
+	}
+
+	if len(s) == 0 {
+		s = []string{blank}
+	}
+
+	return joinGrowthFieldValueString(name, strings.Join(s, ", "))
+}
+
+func (m integrityPanel) View() string {
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			m.makeBlockView(0),
+			m.makeBlockView(1),
+		),
+		m.help.View(m.keys),
+	)
+}
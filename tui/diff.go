@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"fmt"
+	"postal/pokemon"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+const diffShownRows = 12
+
+var (
+	DiffChangedByte = lipgloss.NewStyle().Foreground(DarkerPink).Bold(true)
+	diffWidths      = []int{16, 21, 21}
+)
+
+// Same colours the hex view uses for each part of the structure
+func diffBlockColor(block int) lipgloss.Color {
+	switch block {
+	case pokemon.DiffHeader:
+		return RegularText
+	case pokemon.DiffParty:
+		return HelperText
+	}
+	return hexSubColors[block]
+}
+
+// subStructDiffString is subStructArray2String with the bytes that
+// differ from base picked out
+func subStructDiffString(s, base []byte) string {
+	changed := make(map[int]bool)
+	for _, c := range pokemon.DiffBytes(base, s) {
+		changed[c.Offset] = true
+	}
+
+	str := new(strings.Builder)
+	for i := range s {
+		b := fmt.Sprintf("%02X", s[i])
+		if changed[i] {
+			b = DiffChangedByte.Render(b)
+		}
+		str.WriteString(b + " ")
+	}
+	return str.String()
+}
+
+func diffValue(v, name string) string {
+	if name == "" {
+		return v
+	}
+	return fmt.Sprintf("%s (%s)", v, name)
+}
+
+func makeDiffRow(style lipgloss.Style, cells ...string) string {
+	for i := range cells {
+		cells[i] = style.Width(diffWidths[i]).MaxWidth(diffWidths[i]).Render(cells[i])
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, cells...)
+}
+
+// makeDiffView lists the changed fields going from one mon to the other,
+// coloured by the part of the structure they're in
+func makeDiffView(d pokemon.MonDiff) string {
+	rows := []string{
+		RibbonSumStyle.Render(fmt.Sprintf("%d fields, %d EK3 bytes changed", len(d.Fields), len(d.Bytes))),
+		" ",
+		makeDiffRow(integrityHeader, "Field", "From", "To"),
+	}
+
+	if d.IsEmpty() {
+		rows = append(rows, makeDiffRow(lipgloss.NewStyle(), blank, blank, blank))
+	}
+
+	for i, c := range d.Fields {
+		if i == diffShownRows {
+			rows = append(rows, KeyPair.Render(fmt.Sprintf("+%d more", len(d.Fields)-i)))
+			break
+		}
+
+		rows = append(rows, makeDiffRow(
+			lipgloss.NewStyle().Foreground(diffBlockColor(c.Block)),
+			c.Field,
+			diffValue(c.Old, c.OldName),
+			diffValue(c.New, c.NewName),
+		))
+	}
+
+	return MenuStyle.Align(lipgloss.Left).Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
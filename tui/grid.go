@@ -0,0 +1,323 @@
+package tui
+
+import (
+	"fmt"
+	"postal/boxes"
+	vals "postal/game"
+	"postal/utils"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	gridColumns     = 6
+	gridRows        = boxes.BoxMonCount / gridColumns
+	gridBoxesPerRow = 4
+)
+
+var (
+	GridCellStyle    = lipgloss.NewStyle().Foreground(RegularText)
+	GridBlankStyle   = lipgloss.NewStyle().Foreground(SubText)
+	GridCursorStyle  = lipgloss.NewStyle().Foreground(RegularText).Background(DarkPurple)
+	GridMatchStyle   = lipgloss.NewStyle().Foreground(DarkBlueish).Background(LightGreenish)
+	GridMarkStyle    = lipgloss.NewStyle().Foreground(DarkBlueish).Background(DarkerPink)
+	GridGlitchStyle  = lipgloss.NewStyle().Foreground(LightPurple)
+	GridBoxStyle     = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("240"))
+	GridActiveBoxSty = lipgloss.NewStyle().Inherit(GridBoxStyle).BorderForeground(LightGreenish)
+)
+
+// Sent up to the main model whenever mons were moved around in the storage
+type storageChangedMsg struct{}
+
+type pcGrid struct {
+	storage *boxes.PCStorage
+	cursor  boxes.MonLocation
+	mark    *boxes.MonLocation
+	matches []boxes.MonLocation
+	filter  textinput.Model
+	help    help.Model
+	keys    *GridKeyMap
+	err     error
+}
+
+func NewPCGrid(s *boxes.PCStorage) pcGrid {
+	f := textinput.New()
+	f.Placeholder = "species 143 / pid 1A2B3C4D / otid ... / item Master Ball / glitch"
+	f.Cursor.Style = lipgloss.NewStyle().Foreground(LightPink)
+	f.CharLimit = 64
+	f.Width = 64
+
+	return pcGrid{
+		storage: s,
+		filter:  f,
+		help:    help.New(),
+		keys:    &GridKeys,
+	}
+}
+
+func (m pcGrid) Init() tea.Cmd { return nil }
+
+func (m pcGrid) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		return m.updateKeys(msg)
+	}
+	return m, nil
+}
+
+func (m pcGrid) updateKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	if m.filter.Focused() {
+		if key.Matches(msg, m.keys.Enter) {
+			m.err = m.applyFilter(m.filter.Value())
+			m.filter.Blur()
+			return m, nil
+		}
+
+		m.filter, cmd = m.filter.Update(msg)
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.Help):
+		m.help.ShowAll = !m.help.ShowAll
+
+	case key.Matches(msg, m.keys.Filter):
+		return m, m.filter.Focus()
+
+	case key.Matches(msg, m.keys.Clear):
+		m.filter.Reset()
+		m.matches = nil
+		m.err = nil
+
+	case key.Matches(msg, m.keys.Up):
+		if m.cursor.Slot >= gridColumns {
+			m.cursor.Slot -= gridColumns
+		}
+
+	case key.Matches(msg, m.keys.Down):
+		if m.cursor.Slot+gridColumns < boxes.BoxMonCount {
+			m.cursor.Slot += gridColumns
+		}
+
+	case key.Matches(msg, m.keys.Left):
+		if m.cursor.Slot%gridColumns > 0 {
+			m.cursor.Slot--
+		}
+
+	case key.Matches(msg, m.keys.Right):
+		if m.cursor.Slot%gridColumns < gridColumns-1 {
+			m.cursor.Slot++
+		}
+
+	case key.Matches(msg, m.keys.Tab):
+		m.cursor.Box = (m.cursor.Box + 1) % boxes.BoxCount
+
+	case key.Matches(msg, m.keys.ShTab):
+		m.cursor.Box = (m.cursor.Box + boxes.BoxCount - 1) % boxes.BoxCount
+
+	case key.Matches(msg, m.keys.Enter):
+		p := m.storage.GetMon(m.cursor)
+		if p != nil && !p.IsBlank() {
+			return m, returnToMain(*p, monSlot{box: m.cursor.Box, index: m.cursor.Slot})
+		}
+
+	case key.Matches(msg, m.keys.Mark):
+		if m.mark != nil && *m.mark == m.cursor {
+			m.mark = nil
+		} else {
+			l := m.cursor
+			m.mark = &l
+		}
+
+	case key.Matches(msg, m.keys.Move),
+		key.Matches(msg, m.keys.Swap),
+		key.Matches(msg, m.keys.Clone):
+
+		if m.mark == nil {
+			return m, nil
+		}
+
+		switch {
+		case key.Matches(msg, m.keys.Move):
+			m.err = m.storage.MoveMon(*m.mark, m.cursor)
+		case key.Matches(msg, m.keys.Swap):
+			m.err = m.storage.SwapMons(*m.mark, m.cursor)
+		case key.Matches(msg, m.keys.Clone):
+			m.err = m.storage.CloneMon(*m.mark, m.cursor)
+		}
+
+		m.mark = nil
+		if m.filter.Value() != "" {
+			m.applyFilter(m.filter.Value())
+		}
+
+		return m, func() tea.Msg { return storageChangedMsg{} }
+	}
+
+	return m, nil
+}
+
+// Filters are written as "<field> <value>" where field is one of
+// boxes.SearchFieldNames. PID and OTID are read as hex
+func (m *pcGrid) applyFilter(s string) error {
+	m.matches = nil
+
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+
+	f, v, _ := strings.Cut(s, " ")
+	field := slices.Index(boxes.SearchFieldNames, strings.ToLower(f))
+	v = strings.TrimSpace(v)
+
+	var n uint32
+	switch field {
+	case boxes.SearchSpecies:
+		sp, err := parseSpeciesInput(v)
+		if err != nil {
+			return err
+		}
+		n = uint32(sp)
+
+	case boxes.SearchPID, boxes.SearchOTID:
+		p, err := strconv.ParseUint(strings.TrimPrefix(v, "0x"), 16, 32)
+		if err != nil {
+			return utils.ErrOutOfRange
+		}
+		n = uint32(p)
+
+	case boxes.SearchItem:
+		it, err := parseItemInput(v)
+		if err != nil {
+			return err
+		}
+		n = uint32(it)
+
+	case boxes.SearchGlitched:
+
+	default:
+		return fmt.Errorf("unknown filter %q", f)
+	}
+
+	m.matches = m.storage.Search(field, n)
+	return nil
+}
+
+// Species can be entered by name or by their internal index
+func parseSpeciesInput(s string) (uint16, error) {
+	name := utils.SanitizeSearch(s)
+	for i, v := range vals.SpeciesDataList {
+		if v.Name == name {
+			return uint16(i), nil
+		}
+	}
+
+	n, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return 0, utils.ErrOutOfRange
+	}
+
+	return uint16(n), nil
+}
+
+func (m pcGrid) View() string {
+	grids := make([]string, boxes.BoxCount)
+	for i := range grids {
+		grids[i] = m.renderBox(i)
+	}
+
+	var rows []string
+	for i := 0; i < len(grids); i += gridBoxesPerRow {
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, grids[i:min(i+gridBoxesPerRow, len(grids))]...))
+	}
+
+	status := m.selectedString()
+	if m.err != nil {
+		status = RibbonXMarkEnumStyle.Render(m.err.Error())
+	} else if m.filter.Value() != "" {
+		status = fmt.Sprintf("%s   %d match(es)", status, len(m.matches))
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		lipgloss.JoinVertical(lipgloss.Left, rows...),
+		MailMenuStyle.Render(m.filter.View()),
+		" "+status,
+		m.help.View(m.keys),
+	)
+}
+
+func (m *pcGrid) renderBox(b int) string {
+	box := &m.storage.Boxes[b]
+
+	lines := make([]string, gridRows+1)
+	lines[0] = fmt.Sprintf("%2d %-9s", box.Num, box.Name)
+
+	for r := range gridRows {
+		cells := make([]string, gridColumns)
+		for c := range gridColumns {
+			cells[c] = m.renderCell(boxes.MonLocation{Box: b, Slot: r*gridColumns + c})
+		}
+		lines[r+1] = lipgloss.JoinHorizontal(lipgloss.Top, cells...)
+	}
+
+	out := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	if b == m.cursor.Box {
+		return GridActiveBoxSty.Render(out)
+	}
+	return GridBoxStyle.Render(out)
+}
+
+func (m *pcGrid) renderCell(l boxes.MonLocation) string {
+	p := m.storage.GetMon(l)
+
+	s, style := "····", GridBlankStyle
+	if !p.IsBlank() {
+		s = fmt.Sprintf("%04X", p.Sub0.Species)
+		style = GridCellStyle
+		if p.IsGlitched() {
+			style = GridGlitchStyle
+		}
+	}
+
+	switch {
+	case l == m.cursor:
+		style = GridCursorStyle
+	case m.mark != nil && l == *m.mark:
+		style = GridMarkStyle
+	case slices.Contains(m.matches, l):
+		style = GridMatchStyle
+	}
+
+	// Keep the gap unstyled so highlights don't bleed into the next cell
+	if l.Slot%gridColumns == gridColumns-1 {
+		return style.Render(s)
+	}
+	return style.Render(s) + " "
+}
+
+func (m *pcGrid) selectedString() string {
+	s := fmt.Sprintf("Box %d Slot %d", m.cursor.Box+1, m.cursor.Slot+1)
+
+	if m.mark != nil {
+		s = fmt.Sprintf("%s   Marked: Box %d Slot %d", s, m.mark.Box+1, m.mark.Slot+1)
+	}
+
+	p := m.storage.GetMon(m.cursor)
+	if p.IsBlank() {
+		return s + ": Empty"
+	}
+
+	_, species := p.GetSpecies()
+	_, item := p.GetHeldItem()
+	return fmt.Sprintf("%s: %s Lv %d  PID %08X  OTID %08X  Item %s", s, species, p.Level, p.PID, p.OTID, item)
+}
@@ -3,6 +3,7 @@ package tui
 import (
 	"fmt"
 	vals "postal/game"
+	"postal/pokemon"
 	"postal/utils"
 	"strconv"
 
@@ -14,10 +15,11 @@ import (
 
 type GrowthEditor struct {
 	*BaseEditorModel
+	err error
 }
 
 var (
-	ExpandedGrowthBlockNames = []string{"Species", "Item", "EXP", "Friendship", "PP 1", "PP 2", "PP 3", "PP 4"}
+	ExpandedGrowthBlockNames = []string{"Species", "Item", "EXP", "Friendship", "PP 1", "PP 2", "PP 3", "PP 4", "Level"}
 )
 
 func (m *GrowthEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -92,6 +94,19 @@ func (m *GrowthEditor) View() string {
 		if err == nil {
 			m.vals[1] = uint(item)
 		}
+	case 8: // Level, sets EXP to the least the pending species needs for it
+		val, err := strconv.ParseUint(m.inputs[8].Value(), 10, 8)
+		if err == nil && val <= pokemon.MaxLevel {
+			m.vals[8] = uint(val)
+			m.err = nil
+
+			rate := pokemon.SpeciesGrowthRate(uint16(m.vals[0]))
+			if exp, err := pokemon.ExperienceForLevel(rate, uint8(val)); err != nil {
+				m.err = err
+			} else {
+				m.vals[2] = uint(exp)
+			}
+		}
 	default: // Rest of values that need numbers to parse
 		val, err := strconv.ParseUint(m.inputs[m.focusIndex].Value(), 10, 32)
 		if err == nil {
@@ -107,9 +122,10 @@ func (m *GrowthEditor) View() string {
 		lipgloss.Center,
 		lipgloss.JoinVertical(lipgloss.Center, editLeft...),
 		lipgloss.JoinVertical(lipgloss.Center, editRight...),
+		lipgloss.JoinVertical(lipgloss.Center, editor[8]),
 	)
 
-	ts := make([]string, 8)
+	ts := make([]string, len(m.inputs))
 
 	for i := range m.inputs {
 		s := lipgloss.NewStyle().Padding(0, 1)
@@ -128,10 +144,43 @@ func (m *GrowthEditor) View() string {
 		" ",
 		ret,
 		" ",
-		makeGrowthSubStructView(&m.pks, subStructArray2String(s0)),
+		lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			makeGrowthSubStructView(&m.pks, subStructArray2String(s0)),
+			makeLevelView(&m.pks, m.err),
+		),
 	)
 }
 
+// Level the committed experience gives and how far off the next one is
+func makeLevelView(pks *pokemon.PStructure, err error) string {
+	rate := pks.GrowthRate()
+	name, ok := vals.GrowthRates[rate]
+	if !ok {
+		name = "Glitched"
+	}
+
+	rows := []string{joinGrowthFieldValueString("Growth", fmt.Sprintf("%s (%d)", name, rate))}
+
+	level, lerr := pokemon.LevelForExperience(rate, pks.Sub0.Experience)
+	if lerr != nil {
+		err = lerr
+	} else {
+		at, _ := pokemon.ExperienceForLevel(rate, level)
+		next, _ := pokemon.ExperienceToNextLevel(rate, pks.Sub0.Experience)
+		rows = append(rows,
+			joinGrowthFieldValueString("Level", fmt.Sprintf("%d", level)),
+			joinGrowthFieldValueString("Level EXP", fmt.Sprintf("%d", at)),
+			joinGrowthFieldValueString("To Next", fmt.Sprintf("%d", next)),
+		)
+	}
+
+	if err != nil {
+		rows = append(rows, " ", RibbonXMarkEnumStyle.Width(34).Render(err.Error()))
+	}
+	return SubStructBlock.Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
 func (m *GrowthEditor) CommitEdits() {
 	m.pks.Sub0.Species = uint16(m.vals[0])
 	m.pks.Sub0.HeldItem = uint16(m.vals[1])
@@ -156,6 +205,9 @@ func (m *GrowthEditor) UpdateValues() {
 	for i := range 3 {
 		m.vals[i+4] = b[i]
 	}
+
+	level, _ := pokemon.LevelForExperience(m.pks.GrowthRate(), m.pks.Sub0.Experience)
+	m.vals[8] = uint(level)
 }
 
 func (m *GrowthEditor) GetKeys() string {
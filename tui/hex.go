@@ -0,0 +1,334 @@
+package tui
+
+import (
+	"encoding/binary"
+	"fmt"
+	"postal/pokemon"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const hexRowLength = 16
+
+// hexSpan is a run of bytes in the raw structure that belong to one field
+type hexSpan struct {
+	name  string
+	start int
+	end   int
+	color lipgloss.Color
+}
+
+var hexHeaderSpans = []hexSpan{
+	{"PID", 0x00, 0x04, DarkerPink},
+	{"OTID", 0x04, 0x08, LightPink},
+	{"Nickname", 0x08, 0x12, LightPurple},
+	{"Language", 0x12, 0x13, SubText},
+	{"Flags", 0x13, 0x14, SubText},
+	{"OT Name", 0x14, 0x1B, DarkerPurple},
+	{"Markings", 0x1B, 0x1C, SubText},
+	{"Checksum", 0x1C, 0x1E, RegularText},
+	{"Padding", 0x1E, 0x20, SubText},
+}
+
+var hexPartySpans = []hexSpan{
+	{"Status", 0x50, 0x54, HelperText},
+	{"Level", 0x54, 0x55, HelperText},
+	{"Mail ID", 0x55, 0x56, HelperText},
+	{"Current HP", 0x56, 0x58, HelperText},
+	{"Max HP", 0x58, 0x5A, HelperText},
+	{"Atk", 0x5A, 0x5C, HelperText},
+	{"Def", 0x5C, 0x5E, HelperText},
+	{"Spe", 0x5E, 0x60, HelperText},
+	{"Spa", 0x60, 0x62, HelperText},
+	{"Spd", 0x62, 0x64, HelperText},
+}
+
+var (
+	hexSubNames  = []string{"Growth", "Attacks", "EVs & Condition", "Misc"}
+	hexSubColors = []lipgloss.Color{LightGreenish, LightBlueish, MidBlueish, DarkPurple}
+)
+
+var hexLegend = []struct {
+	name  string
+	color lipgloss.Color
+}{
+	{"PID", DarkerPink},
+	{"OTID", LightPink},
+	{"Nickname", LightPurple},
+	{"OT Name", DarkerPurple},
+	{"Checksum", RegularText},
+	{"Header", SubText},
+	{"G", LightGreenish},
+	{"A", LightBlueish},
+	{"E", MidBlueish},
+	{"M", DarkPurple},
+	{"Party", HelperText},
+}
+
+// hexSpans lays out the fields of data as it's shown. Encrypted substructs
+// sit wherever the PID in data puts them, decrypted ones are always GAEM
+func hexSpans(data []byte, encrypted bool) []hexSpan {
+	spans := slices.Clone(hexHeaderSpans)
+
+	order := []int{0, 1, 2, 3}
+	if encrypted {
+		pid := binary.LittleEndian.Uint32(data[0x0:0x4])
+		order = (&pokemon.PStructure{PID: pid}).GetMonSubStructOrder()
+	}
+
+	for i, v := range order {
+		o := pokemon.SubStructOffsetMap[i]
+		spans = append(spans, hexSpan{hexSubNames[v], int(o[0]), int(o[1]), hexSubColors[v]})
+	}
+
+	return append(spans, hexPartySpans...)
+}
+
+func spanAt(spans []hexSpan, i int) hexSpan {
+	for _, s := range spans {
+		if i >= s.start && i < s.end {
+			return s
+		}
+	}
+	return hexSpan{}
+}
+
+// HexEditor edits the raw pk3 or ek3 bytes of the mon directly, every
+// change is decoded again straight away
+type HexEditor struct {
+	*BaseEditorModel
+	data      []byte
+	orig      pokemon.PStructure
+	encrypted bool
+	cursor    int
+	// The high nibble of the byte under the cursor was typed
+	nibble bool
+	keys   *HexKeyMap
+	err    error
+}
+
+func (m *HexEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+
+	case tea.KeyMsg:
+
+		switch {
+		case key.Matches(msg, m.keys.Fix):
+			m.fixChecksum()
+			return m, nil
+
+		case key.Matches(msg, m.keys.Reset):
+			m.SetPokemon(m.orig)
+			return m, nil
+
+		case key.Matches(msg, m.keys.View):
+			if m.encrypted {
+				m.data = pokemon.DecryptMonData(m.data)
+			} else {
+				m.data = pokemon.EncryptMonData(m.data)
+			}
+			m.encrypted = !m.encrypted
+			m.nibble = false
+			return m, nil
+
+		case key.Matches(msg, m.keys.Help):
+			m.help.ShowAll = !m.help.ShowAll
+
+		case key.Matches(msg, m.keys.Up):
+			m.moveCursor(-hexRowLength)
+
+		case key.Matches(msg, m.keys.Down):
+			m.moveCursor(hexRowLength)
+
+		case key.Matches(msg, m.keys.Left):
+			m.moveCursor(-1)
+
+		case key.Matches(msg, m.keys.Right):
+			m.moveCursor(1)
+
+		case msg.Type == tea.KeyRunes && !msg.Alt && len(msg.Runes) == 1:
+			if v, err := strconv.ParseUint(string(msg.Runes), 16, 8); err == nil {
+				m.typeNibble(byte(v))
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *HexEditor) moveCursor(d int) {
+	if c := m.cursor + d; c >= 0 && c < len(m.data) {
+		m.cursor = c
+	}
+	m.nibble = false
+}
+
+// Typing fills the high nibble then the low one and moves on
+func (m *HexEditor) typeNibble(v byte) {
+	b := &m.data[m.cursor]
+
+	if !m.nibble {
+		*b = v<<4 | *b&0x0F
+		m.nibble = true
+	} else {
+		*b = *b&0xF0 | v
+		m.moveCursor(1)
+	}
+
+	m.CommitEdits()
+}
+
+// Stores the checksum the substructs add up to, clearing a mismatch
+func (m *HexEditor) fixChecksum() {
+	binary.LittleEndian.PutUint16(m.data[0x1C:0x1E], m.pks.ComputeChecksum())
+	m.CommitEdits()
+}
+
+func (m *HexEditor) makeGridView(spans []hexSpan) string {
+	var b strings.Builder
+
+	for i, v := range m.data {
+		if i%hexRowLength == 0 {
+			if i > 0 {
+				b.WriteByte('\n')
+			}
+			b.WriteString(KeyPair.Render(fmt.Sprintf("%02X ", i)))
+		}
+
+		style := lipgloss.NewStyle().Foreground(spanAt(spans, i).color)
+		if i == m.cursor {
+			style = style.Reverse(true).Bold(true)
+		}
+
+		b.WriteByte(' ')
+		b.WriteString(style.Render(fmt.Sprintf("%02X", v)))
+	}
+
+	return b.String()
+}
+
+func (m *HexEditor) makeLegendView() string {
+	l := make([]string, len(hexLegend))
+	for i, v := range hexLegend {
+		l[i] = lipgloss.NewStyle().Foreground(v.color).Render(v.name)
+	}
+	return strings.Join(l, " ")
+}
+
+func (m *HexEditor) makeCursorView(spans []hexSpan) string {
+	s := spanAt(spans, m.cursor)
+	field := s.name
+
+	// Values only mean something for the fields that are plain numbers
+	value := blank
+	switch bs := m.data[s.start:s.end]; len(bs) {
+	case 1:
+		value = fmt.Sprintf("0x%02X", bs[0])
+	case 2:
+		value = fmt.Sprintf("0x%04X", binary.LittleEndian.Uint16(bs))
+	case 4:
+		value = fmt.Sprintf("0x%08X", binary.LittleEndian.Uint32(bs))
+	}
+
+	if slices.Contains(hexSubNames, s.name) {
+		field = fmt.Sprintf("%s +0x%02X", s.name, m.cursor-s.start)
+		value = blank
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		nameRow("Offset", fmt.Sprintf("0x%02X", m.cursor)),
+		nameRow("Field", field),
+		nameRow("Value", value),
+	)
+}
+
+func (m *HexEditor) makeChecksumView() string {
+	c := m.pks.CheckBadEgg()
+
+	state := RibbonCheckEnumStyle.Render("OK")
+	if c.WillBeBadEgg() {
+		state = RibbonXMarkEnumStyle.Render("Bad Egg")
+	}
+
+	return nameRow("Checksum", fmt.Sprintf("%04X / %04X %s", c.Stored, c.Computed, state))
+}
+
+func (m *HexEditor) View() string {
+	spans := hexSpans(m.data, m.encrypted)
+
+	mode := "PK3 (decrypted)"
+	if m.encrypted {
+		mode = "EK3 (encrypted)"
+	}
+	_, order := m.pks.GetMonSubStructOrderString()
+
+	rows := []string{
+		nameRow("Showing", mode),
+		nameRow("Order", order),
+		" ",
+		m.makeGridView(spans),
+		" ",
+		m.makeLegendView(),
+		" ",
+		m.makeCursorView(spans),
+		m.makeChecksumView(),
+	}
+	if m.err != nil {
+		rows = append(rows, RibbonXMarkEnumStyle.Render(m.err.Error()))
+	}
+
+	raw := MenuStyle.Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, raw, generateMonViewOrder(&m.pks))
+}
+
+func (m *HexEditor) SetPokemon(pks pokemon.PStructure) {
+	m.pks = pks
+	m.orig = pks
+	m.UpdateValues()
+}
+
+// The stored checksum is kept so a mismatch shows up in the bytes. A mon
+// whose names don't encode keeps the bytes already shown
+func (m *HexEditor) UpdateValues() {
+	var data []byte
+	var err error
+	if m.encrypted {
+		data, err = m.pks.ToEK3()
+	} else {
+		data, err = m.pks.ToPK3()
+	}
+
+	m.nibble = false
+	if m.err = err; err != nil {
+		return
+	}
+
+	m.data = data
+	binary.LittleEndian.PutUint16(m.data[0x1C:0x1E], m.pks.Checksum)
+}
+
+func (m *HexEditor) CommitEdits() {
+	m.pks = pokemon.GeneratePokemonFromRawData(m.data, false, !m.encrypted)
+}
+
+func (m *HexEditor) GetKeys() string {
+	return m.help.View(m.keys)
+}
+
+func NewHexEditor() *HexEditor {
+	return &HexEditor{
+		BaseEditorModel: &BaseEditorModel{
+			help: help.New(),
+		},
+		data: make([]byte, 0x64),
+		keys: &HexKeys,
+	}
+}
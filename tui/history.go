@@ -0,0 +1,192 @@
+package tui
+
+import (
+	"fmt"
+	"postal/pokemon"
+	"reflect"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	historyLimit = 100
+
+	// Changes listed in a description before the rest are counted
+	historyShownChanges = 3
+	historyShownRows    = 20
+)
+
+type historyEntry struct {
+	label string
+	desc  string
+	pks   pokemon.PStructure
+	slot  *monSlot
+
+	// What the editor held before, descriptions are made against this
+	// since other editors may hold a different copy
+	from pokemon.PStructure
+}
+
+// monHistory is the undo/redo stack shared by every editor. The entry at
+// pos is the state the editors were last set to or left in
+type monHistory struct {
+	entries []historyEntry
+	pos     int
+}
+
+// Asks the main model to load a history entry into every editor
+type historyJumpMsg struct {
+	index int
+}
+
+func newMonHistory(pks pokemon.PStructure) *monHistory {
+	return &monHistory{
+		entries: []historyEntry{{label: "Start", desc: "Start", pks: pks}},
+	}
+}
+
+func (h *monHistory) current() historyEntry {
+	return h.entries[h.pos]
+}
+
+// push drops everything that was undone. Edits carrying on from the last
+// entry are folded into it so typing isn't one step a key
+func (h *monHistory) push(label string, before, after pokemon.PStructure, slot *monSlot) {
+	e := h.entries[h.pos]
+	top := h.pos == len(h.entries)-1 && h.pos > 0
+	if top && strings.HasPrefix(label, "Edit") && e.label == label && reflect.DeepEqual(e.pks, before) {
+		before = e.from
+		h.pos--
+	}
+
+	h.entries = append(h.entries[:h.pos+1], historyEntry{
+		label: label,
+		desc:  describeChanges(label, before, after),
+		pks:   after,
+		slot:  slot,
+		from:  before,
+	})
+
+	if n := len(h.entries) - historyLimit; n > 0 {
+		h.entries = h.entries[n:]
+	}
+	h.pos = len(h.entries) - 1
+}
+
+func (h *monHistory) jump(i int) (historyEntry, bool) {
+	if i < 0 || i >= len(h.entries) {
+		return historyEntry{}, false
+	}
+
+	h.pos = i
+	return h.entries[i], true
+}
+
+func (h *monHistory) undo() (historyEntry, bool) {
+	return h.jump(h.pos - 1)
+}
+
+func (h *monHistory) redo() (historyEntry, bool) {
+	return h.jump(h.pos + 1)
+}
+
+func describeChanges(label string, before, after pokemon.PStructure) string {
+	d := pokemon.DiffMons(&before, &after)
+	if len(d.Fields) == 0 {
+		return label
+	}
+
+	c := make([]string, len(d.Fields))
+	for i, f := range d.Fields {
+		c[i] = fmt.Sprintf("%s %s→%s", strings.ToLower(f.Field), f.Old, f.New)
+	}
+
+	if n := len(c) - historyShownChanges; n > 0 {
+		c = append(c[:historyShownChanges], fmt.Sprintf("+%d more", n))
+	}
+
+	return label + ": " + strings.Join(c, ", ")
+}
+
+type historyList struct {
+	hist   *monHistory
+	cursor int
+	help   help.Model
+	keys   *HistoryKeyMap
+}
+
+func NewHistoryList(h *monHistory) historyList {
+	return historyList{
+		hist:   h,
+		cursor: h.pos,
+		help:   help.New(),
+		keys:   &HistoryKeys,
+	}
+}
+
+func (m historyList) Init() tea.Cmd { return nil }
+
+func (m historyList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Help):
+			m.help.ShowAll = !m.help.ShowAll
+
+		case key.Matches(msg, m.keys.Up):
+			m.cursor = max(m.cursor-1, 0)
+
+		case key.Matches(msg, m.keys.Down):
+			m.cursor = min(m.cursor+1, len(m.hist.entries)-1)
+
+		case key.Matches(msg, m.keys.Enter):
+			i := m.cursor
+			return m, func() tea.Msg { return historyJumpMsg{index: i} }
+		}
+	}
+	return m, nil
+}
+
+func (m historyList) View() string {
+	rows := []string{RibbonSumStyle.Render("History"), " "}
+
+	n := len(m.hist.entries)
+	start := max(min(m.cursor-historyShownRows/2, n-historyShownRows), 0)
+
+	for i := start; i < min(start+historyShownRows, n); i++ {
+		e := m.hist.entries[i]
+		mark := "  "
+		if i == m.hist.pos {
+			mark = CheckMark + " "
+		}
+
+		style := lipgloss.NewStyle().Foreground(SubText)
+		switch {
+		case i == m.cursor:
+			style = FocusedText.Bold(true)
+		case i > m.hist.pos:
+			// Undone, the next edit drops these
+			style = style.Faint(true)
+		}
+
+		rows = append(rows, style.Render(fmt.Sprintf("%s%3d  %s", mark, i, e.desc)))
+	}
+
+	// What loading the selected entry would change
+	cur := m.hist.current().pks
+	sel := m.hist.entries[m.cursor].pks
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			MenuStyle.Align(lipgloss.Left).Render(lipgloss.JoinVertical(lipgloss.Left, rows...)),
+			makeDiffView(pokemon.DiffMons(&cur, &sel)),
+		),
+		m.help.View(m.keys),
+	)
+}
@@ -38,7 +38,14 @@ type EditorKeyMap struct {
 	Origin  key.Binding
 	Ribbon  key.Binding
 	Search  key.Binding
+	Names   key.Binding
+	Hex     key.Binding
+	Undo    key.Binding
+	Redo    key.Binding
+	History key.Binding
+	Gallery key.Binding
 	File    key.Binding
+	Write   key.Binding
 }
 
 func (k EditorKeyMap) ShortHelp() []key.Binding {
@@ -48,18 +55,24 @@ func (k EditorKeyMap) ShortHelp() []key.Binding {
 func (k EditorKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Mail, k.Growth, k.Attacks, k.EVSC, k.Misc, k.Search, k.File},
-		{k.Save, k.Commit, k.Reset},
-		{k.Ribbon, k.Origin, k.Stats},
+		{k.Save, k.Commit, k.Reset, k.Write},
+		{k.Undo, k.Redo, k.History, k.Gallery},
+		{k.Ribbon, k.Origin, k.Stats, k.Names, k.Hex},
 		k.ShortHelp(),
 	}
 }
 
 type MailKeyMap struct {
 	*EditorKeyMap
-	View key.Binding
-	Mode key.Binding
-	Swap key.Binding
-	File key.Binding
+	View   key.Binding
+	Mode   key.Binding
+	Swap   key.Binding
+	File   key.Binding
+	Load   key.Binding
+	Move   key.Binding
+	Edit   key.Binding
+	Remove key.Binding
+	Export key.Binding
 }
 
 func (k MailKeyMap) ShortHelp() []key.Binding {
@@ -68,16 +81,86 @@ func (k MailKeyMap) ShortHelp() []key.Binding {
 
 func (k MailKeyMap) FullHelp() [][]key.Binding {
 	b := k.EditorKeyMap.FullHelp()
-	return append(b, []key.Binding{k.Mode, k.View, k.Swap, k.File})
+	return append(b,
+		[]key.Binding{k.Mode, k.View, k.Swap, k.File, k.Load},
+		[]key.Binding{k.Move, k.Edit, k.Remove, k.Export},
+	)
 }
 
-type BoxViewKeyMap struct {
-	Up    key.Binding
-	Down  key.Binding
-	Tab   key.Binding
-	ShTab key.Binding
+type HexKeyMap struct {
+	*EditorKeyMap
+	View key.Binding
+	Fix  key.Binding
+}
+
+func (k HexKeyMap) ShortHelp() []key.Binding {
+	return k.EditorKeyMap.ShortHelp()
+}
+
+func (k HexKeyMap) FullHelp() [][]key.Binding {
+	b := k.EditorKeyMap.FullHelp()
+	return append(b, []key.Binding{k.View, k.Fix})
+}
+
+type StatKeyMap struct {
+	*EditorKeyMap
+	Recalc      key.Binding
+	RecalcClamp key.Binding
+}
+
+func (k StatKeyMap) ShortHelp() []key.Binding {
+	return k.EditorKeyMap.ShortHelp()
+}
+
+func (k StatKeyMap) FullHelp() [][]key.Binding {
+	b := k.EditorKeyMap.FullHelp()
+	return append(b, []key.Binding{k.Recalc, k.RecalcClamp})
+}
+
+type HistoryKeyMap struct {
+	*EditorKeyMap
 	Enter key.Binding
-	Quit  key.Binding
+}
+
+func (k HistoryKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Enter, k.Undo, k.Redo, k.History, k.Quit}
+}
+
+func (k HistoryKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
+
+type TemplateKeyMap struct {
+	*EditorKeyMap
+	Enter  key.Binding
+	Reload key.Binding
+}
+
+func (k TemplateKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Enter, k.Reload, k.Gallery, k.Quit}
+}
+
+func (k TemplateKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
+
+type BoxViewKeyMap struct {
+	Up      key.Binding
+	Down    key.Binding
+	Tab     key.Binding
+	ShTab   key.Binding
+	Enter   key.Binding
+	Party   key.Binding
+	Trainer key.Binding
+	Bag     key.Binding
+	Grid    key.Binding
+	Check   key.Binding
+	Batch   key.Binding
+	Ace     key.Binding
+	Rename  key.Binding
+	Write   key.Binding
+	Help    key.Binding
+	Quit    key.Binding
 }
 
 func (k BoxViewKeyMap) ShortHelp() []key.Binding {
@@ -85,7 +168,80 @@ func (k BoxViewKeyMap) ShortHelp() []key.Binding {
 }
 
 func (k BoxViewKeyMap) FullHelp() [][]key.Binding {
-	return [][]key.Binding{k.ShortHelp()}
+	return [][]key.Binding{k.ShortHelp(), {k.Party, k.Trainer, k.Bag, k.Grid, k.Check, k.Batch, k.Ace, k.Rename, k.Write, k.Help}}
+}
+
+type AceKeyMap struct {
+	*BoxViewKeyMap
+	Mode  key.Binding
+	Next  key.Binding
+	Prev  key.Binding
+	Apply key.Binding
+}
+
+func (k AceKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Mode, k.Next, k.Prev, k.Apply, k.Write, k.Quit}
+}
+
+func (k AceKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp(), {k.Ace, k.Party, k.Grid, k.Help}}
+}
+
+type BatchKeyMap struct {
+	*BoxViewKeyMap
+	Next   key.Binding
+	Prev   key.Binding
+	Select key.Binding
+	Clear  key.Binding
+	Load   key.Binding
+}
+
+func (k BatchKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Tab, k.Next, k.Prev, k.Load, k.Quit}
+}
+
+func (k BatchKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp(), {k.ShTab, k.Select, k.Clear, k.Batch, k.Party, k.Grid, k.Help}}
+}
+
+type BagKeyMap struct {
+	*BoxViewKeyMap
+	Left  key.Binding
+	Right key.Binding
+	Write key.Binding
+}
+
+func (k BagKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Left, k.Right, k.Tab, k.Enter, k.Quit}
+}
+
+func (k BagKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp(), {k.ShTab, k.Write, k.Party, k.Trainer, k.Check, k.Help}}
+}
+
+type GridKeyMap struct {
+	*BoxViewKeyMap
+	Left   key.Binding
+	Right  key.Binding
+	Filter key.Binding
+	Clear  key.Binding
+	Mark   key.Binding
+	Move   key.Binding
+	Swap   key.Binding
+	Clone  key.Binding
+	Write  key.Binding
+}
+
+func (k GridKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Left, k.Right, k.Tab, k.Enter, k.Quit}
+}
+
+func (k GridKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		k.ShortHelp(),
+		{k.ShTab, k.Filter, k.Clear, k.Mark, k.Move, k.Swap, k.Clone},
+		{k.Write, k.Party, k.Trainer, k.Bag, k.Grid, k.Check, k.Help},
+	}
 }
 
 var (
@@ -100,6 +256,18 @@ var (
 		Runes: []rune{'F'},
 		Alt:   true,
 	}
+
+	WriteKey = tea.Key{
+		Type:  tea.KeyRunes,
+		Runes: []rune{'W'},
+		Alt:   true,
+	}
+)
+
+var (
+	MoveKey  = tea.Key{Type: tea.KeyRunes, Runes: []rune{'m'}, Alt: true}
+	SwapKey  = tea.Key{Type: tea.KeyRunes, Runes: []rune{'x'}, Alt: true}
+	CloneKey = tea.Key{Type: tea.KeyRunes, Runes: []rune{'c'}, Alt: true}
 )
 
 var (
@@ -107,6 +275,16 @@ var (
 	RibbonViewKey = tea.Key{Type: tea.KeyRunes, Runes: []rune{'r'}, Alt: true}
 	OriginViewKey = tea.Key{Type: tea.KeyRunes, Runes: []rune{'o'}, Alt: true}
 	StatViewKey   = tea.Key{Type: tea.KeyRunes, Runes: []rune{'s'}, Alt: true}
+	NameViewKey   = tea.Key{Type: tea.KeyRunes, Runes: []rune{'n'}, Alt: true}
+	HexViewKey    = tea.Key{Type: tea.KeyRunes, Runes: []rune{'h'}, Alt: true}
+)
+
+var (
+	RedoKey    = tea.Key{Type: tea.KeyRunes, Runes: []rune{'z'}, Alt: true}
+	BatchKey   = tea.Key{Type: tea.KeyRunes, Runes: []rune{'b'}, Alt: true}
+	HistoryKey = tea.Key{Type: tea.KeyRunes, Runes: []rune{'y'}, Alt: true}
+	GalleryKey = tea.Key{Type: tea.KeyRunes, Runes: []rune{'t'}, Alt: true}
+	AceKey     = tea.Key{Type: tea.KeyRunes, Runes: []rune{'a'}, Alt: true}
 )
 
 var DirectionKeys = DirectionKeyMap{
@@ -132,22 +310,168 @@ var EditorKeys = EditorKeyMap{
 	Origin:          key.NewBinding(key.WithKeys(OriginViewKey.String()), key.WithHelp(OriginViewKey.String(), "origin view")),
 	Ribbon:          key.NewBinding(key.WithKeys(RibbonViewKey.String()), key.WithHelp(RibbonViewKey.String(), "ribbon view")),
 	Search:          key.NewBinding(key.WithKeys("ctrl+f"), key.WithHelp("ctrl+f", "switch to search menu")),
+	Names:           key.NewBinding(key.WithKeys(NameViewKey.String()), key.WithHelp(NameViewKey.String(), "name editor")),
+	Hex:             key.NewBinding(key.WithKeys(HexViewKey.String()), key.WithHelp(HexViewKey.String(), "hex view")),
+	Undo:            key.NewBinding(key.WithKeys("ctrl+z"), key.WithHelp("ctrl+z", "undo")),
+	Redo:            key.NewBinding(key.WithKeys(RedoKey.String()), key.WithHelp(RedoKey.String(), "redo")),
+	History:         key.NewBinding(key.WithKeys(HistoryKey.String()), key.WithHelp(HistoryKey.String(), "edit history")),
+	Gallery:         key.NewBinding(key.WithKeys(GalleryKey.String()), key.WithHelp(GalleryKey.String(), "template gallery")),
 	File:            key.NewBinding(key.WithKeys(FileKey.String()), key.WithHelp(FileKey.String(), "open file picker menu")),
+	Write:           key.NewBinding(key.WithKeys(WriteKey.String()), key.WithHelp(WriteKey.String(), "write mon back to save")),
 }
 
 var MailKeys = MailKeyMap{
 	EditorKeyMap: &EditorKeys,
-	View:         key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "switch to quick swap table")),
+	View:         key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "cycle words/quick swap/solver/chain/search/diff")),
 	Mode:         key.NewBinding(key.WithKeys("ctrl+up"), key.WithHelp("ctrl+up", "switch word search mode")),
 	Swap:         key.NewBinding(key.WithKeys("ctrl+w"), key.WithHelp("ctrl+w", "swap edit mon with base mon")),
 	File:         key.NewBinding(key.WithKeys(SaveKey.String()), key.WithHelp(SaveKey.String(), "save base mon to file")),
+	Load:         key.NewBinding(key.WithKeys("ctrl+l"), key.WithHelp("ctrl+l", "load selected solution/step")),
+	Move:         key.NewBinding(key.WithKeys(MoveKey.String()), key.WithHelp(MoveKey.String(), "add box move step")),
+	Edit:         key.NewBinding(key.WithKeys("alt+u"), key.WithHelp("alt+u", "set step to current words")),
+	Remove:       key.NewBinding(key.WithKeys("ctrl+x"), key.WithHelp("ctrl+x", "remove step/cancel search")),
+	Export:       key.NewBinding(key.WithKeys("alt+e"), key.WithHelp("alt+e", "export chain recipe")),
+}
+
+var HexKeys = HexKeyMap{
+	EditorKeyMap: &EditorKeys,
+	View:         key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "toggle pk3/ek3 bytes")),
+	Fix:          key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "fix checksum")),
+}
+
+var StatKeys = StatKeyMap{
+	EditorKeyMap: &EditorKeys,
+	Recalc:       key.NewBinding(key.WithKeys("ctrl+l"), key.WithHelp("ctrl+l", "recalculate and write party stats")),
+	RecalcClamp:  key.NewBinding(key.WithKeys("alt+l"), key.WithHelp("alt+l", "recalculate keeping at least 1 HP")),
+}
+
+var HistoryKeys = HistoryKeyMap{
+	EditorKeyMap: &EditorKeys,
+	Enter:        key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "load entry")),
+}
+
+var TemplateKeys = TemplateKeyMap{
+	EditorKeyMap: &EditorKeys,
+	Enter:        key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "load template")),
+	Reload:       key.NewBinding(key.WithKeys("ctrl+r"), key.WithHelp("ctrl+r", "reload user templates")),
 }
 
 var BoxKeys = BoxViewKeyMap{
-	Up:    key.NewBinding(key.WithKeys("up"), key.WithHelp(" 🠕 ", "move up")),
-	Down:  key.NewBinding(key.WithKeys("down"), key.WithHelp(" 🠗", "move down")),
-	Tab:   key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next box")),
-	ShTab: key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "previous box")),
-	Enter: key.NewBinding(key.WithKeys("enter"), key.WithHelp(" enter", "select pokemon")),
-	Quit:  key.NewBinding(key.WithKeys("esc", "ctrl+c"), key.WithHelp(" esc", "quit")),
+	Up:      key.NewBinding(key.WithKeys("up"), key.WithHelp(" 🠕 ", "move up")),
+	Down:    key.NewBinding(key.WithKeys("down"), key.WithHelp(" 🠗", "move down")),
+	Tab:     key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next box")),
+	ShTab:   key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "previous box")),
+	Enter:   key.NewBinding(key.WithKeys("enter"), key.WithHelp(" enter", "select pokemon")),
+	Party:   key.NewBinding(key.WithKeys("ctrl+p"), key.WithHelp(" ctrl+p", "toggle party/box view")),
+	Trainer: key.NewBinding(key.WithKeys("ctrl+t"), key.WithHelp(" ctrl+t", "trainer card")),
+	Bag:     key.NewBinding(key.WithKeys("ctrl+k"), key.WithHelp(" ctrl+k", "bag editor")),
+	Grid:    key.NewBinding(key.WithKeys("ctrl+o"), key.WithHelp(" ctrl+o", "toggle pc grid")),
+	Check:   key.NewBinding(key.WithKeys("ctrl+y"), key.WithHelp(" ctrl+y", "save integrity")),
+	Batch:   key.NewBinding(key.WithKeys(BatchKey.String()), key.WithHelp(" "+BatchKey.String(), "batch mail over box")),
+	Ace:     key.NewBinding(key.WithKeys(AceKey.String()), key.WithHelp(" "+AceKey.String(), "box name code")),
+	Rename:  key.NewBinding(key.WithKeys("ctrl+r"), key.WithHelp(" ctrl+r", "rename box")),
+	Write:   key.NewBinding(key.WithKeys(WriteKey.String()), key.WithHelp(" "+WriteKey.String(), "write box names to save")),
+	Help:    key.NewBinding(key.WithKeys("ctrl+h"), key.WithHelp(" ctrl+h", "help")),
+	Quit:    key.NewBinding(key.WithKeys("esc", "ctrl+c"), key.WithHelp(" esc", "quit")),
+}
+
+var BagPocketKeys = BoxViewKeyMap{
+	Up:      BoxKeys.Up,
+	Down:    BoxKeys.Down,
+	Tab:     key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next pocket")),
+	ShTab:   key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "previous pocket")),
+	Enter:   key.NewBinding(key.WithKeys("enter"), key.WithHelp(" enter", "commit field")),
+	Party:   BoxKeys.Party,
+	Trainer: BoxKeys.Trainer,
+	Bag:     BoxKeys.Bag,
+	Grid:    BoxKeys.Grid,
+	Check:   BoxKeys.Check,
+	Help:    BoxKeys.Help,
+	Quit:    BoxKeys.Quit,
+}
+
+var BagKeys = BagKeyMap{
+	BoxViewKeyMap: &BagPocketKeys,
+	Left:          key.NewBinding(key.WithKeys("left"), key.WithHelp(" 🠔 ", "previous field")),
+	Right:         key.NewBinding(key.WithKeys("right"), key.WithHelp(" 🠖 ", "next field")),
+	Write:         key.NewBinding(key.WithKeys(WriteKey.String()), key.WithHelp(WriteKey.String(), "write bag to save")),
+}
+
+var GridBoxKeys = BoxViewKeyMap{
+	Up:      BoxKeys.Up,
+	Down:    BoxKeys.Down,
+	Tab:     BoxKeys.Tab,
+	ShTab:   BoxKeys.ShTab,
+	Enter:   key.NewBinding(key.WithKeys("enter"), key.WithHelp(" enter", "select pokemon / apply filter")),
+	Party:   BoxKeys.Party,
+	Trainer: BoxKeys.Trainer,
+	Bag:     BoxKeys.Bag,
+	Grid:    BoxKeys.Grid,
+	Check:   BoxKeys.Check,
+	Help:    BoxKeys.Help,
+	Quit:    BoxKeys.Quit,
+}
+
+var CheckKeys = BoxViewKeyMap{
+	Tab:     key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "select other block")),
+	ShTab:   key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "select other block")),
+	Enter:   key.NewBinding(key.WithKeys("enter"), key.WithHelp(" enter", "load selected block")),
+	Party:   BoxKeys.Party,
+	Trainer: BoxKeys.Trainer,
+	Bag:     BoxKeys.Bag,
+	Grid:    BoxKeys.Grid,
+	Check:   BoxKeys.Check,
+	Help:    BoxKeys.Help,
+	Quit:    BoxKeys.Quit,
+}
+
+var BatchBoxKeys = BoxViewKeyMap{
+	Up:    BoxKeys.Up,
+	Down:  BoxKeys.Down,
+	Tab:   key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next word")),
+	ShTab: key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "previous word")),
+	Party: BoxKeys.Party,
+	Grid:  BoxKeys.Grid,
+	Batch: BoxKeys.Batch,
+	Help:  BoxKeys.Help,
+	Quit:  BoxKeys.Quit,
+}
+
+var AceBoxKeys = BoxViewKeyMap{
+	Party: BoxKeys.Party,
+	Grid:  BoxKeys.Grid,
+	Ace:   BoxKeys.Ace,
+	Write: BoxKeys.Write,
+	Help:  BoxKeys.Help,
+	Quit:  BoxKeys.Quit,
+}
+
+var AceKeys = AceKeyMap{
+	BoxViewKeyMap: &AceBoxKeys,
+	Mode:          key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "toggle THUMB/ARM")),
+	Next:          key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdn", "scroll down")),
+	Prev:          key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "scroll up")),
+	Apply:         key.NewBinding(key.WithKeys("ctrl+l"), key.WithHelp("ctrl+l", "copy code into box names")),
+}
+
+var BatchKeys = BatchKeyMap{
+	BoxViewKeyMap: &BatchBoxKeys,
+	Next:          key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdn", "next box")),
+	Prev:          key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "previous box")),
+	Select:        key.NewBinding(key.WithKeys("ctrl+x"), key.WithHelp("ctrl+x", "toggle slot")),
+	Clear:         key.NewBinding(key.WithKeys("ctrl+u"), key.WithHelp("ctrl+u", "clear selection")),
+	Load:          key.NewBinding(key.WithKeys("ctrl+l"), key.WithHelp("ctrl+l", "load mon with words")),
+}
+
+var GridKeys = GridKeyMap{
+	BoxViewKeyMap: &GridBoxKeys,
+	Left:          key.NewBinding(key.WithKeys("left"), key.WithHelp(" 🠔 ", "move left")),
+	Right:         key.NewBinding(key.WithKeys("right"), key.WithHelp(" 🠖 ", "move right")),
+	Filter:        key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "edit filter")),
+	Clear:         key.NewBinding(key.WithKeys("ctrl+u"), key.WithHelp("ctrl+u", "clear filter")),
+	Mark:          key.NewBinding(key.WithKeys("ctrl+x"), key.WithHelp("ctrl+x", "mark source slot")),
+	Move:          key.NewBinding(key.WithKeys(MoveKey.String()), key.WithHelp(MoveKey.String(), "move marked mon here")),
+	Swap:          key.NewBinding(key.WithKeys(SwapKey.String()), key.WithHelp(SwapKey.String(), "swap marked mon with this slot")),
+	Clone:         key.NewBinding(key.WithKeys(CloneKey.String()), key.WithHelp(CloneKey.String(), "clone marked mon here")),
+	Write:         key.NewBinding(key.WithKeys(WriteKey.String()), key.WithHelp(WriteKey.String(), "write boxes to save")),
 }
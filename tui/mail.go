@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"postal/pokemon"
+	"postal/save"
 	"postal/utils"
 	"strconv"
 
@@ -27,6 +28,10 @@ const (
 const (
 	MailMode = iota
 	SwapMode
+	SolveMode
+	ChainMode
+	BruteMode
+	DiffMode
 )
 
 var ModeNames = []string{"ALL", "PK1", "PK2"}
@@ -43,19 +48,36 @@ type MailEditor struct {
 	view   ViewMode
 	mode   SearchMode
 	swaps  table.Model
+	solver mailSolver
+	chain  mailChain
+	search mailSearch
+	badEgg pokemon.BadEggCheck
 	keys   *MailKeyMap
+	err    error
+
+	// Trainer from the loaded save, nil when editing a lone pk3/ek3
+	trainer *save.TrainerInfo
 }
 
 func (m *MailEditor) getCurrentValues() []uint16 {
-	h, l := m.pks.GetPIDSplit()
-	tid, sid := m.pks.GetTIDSIDComboPair()
+	return getMailValues(&m.pks)
+}
+
+func getMailValues(p *pokemon.PStructure) []uint16 {
+	h, l := p.GetPIDSplit()
+	tid, sid := p.GetTIDSIDComboPair()
 
 	// Temp vals for comparison
 	return []uint16{h, l, sid, tid}
 }
 
 func (m *MailEditor) GenerateNewPIDOTValues() (uint32, uint32) {
-	vl := m.getCurrentValues()
+	return m.generatePIDOTValuesFrom(&m.pks)
+}
+
+// Empty words keep whatever p already has in that slot
+func (m *MailEditor) generatePIDOTValuesFrom(p *pokemon.PStructure) (uint32, uint32) {
+	vl := getMailValues(p)
 	for i := range vl {
 		a := m.vals[i]
 		if a > 0 {
@@ -68,6 +90,33 @@ func (m *MailEditor) GenerateNewPIDOTValues() (uint32, uint32) {
 
 func (m *MailEditor) SetNewPokemon(pks pokemon.PStructure) {
 	m.pksNew = pks
+	m.badEgg = pks.CheckBadEgg()
+}
+
+func (m *MailEditor) SetTrainer(t *save.TrainerInfo) {
+	m.trainer = t
+}
+
+// Shows whether the base and result mons still belong to the save's trainer
+func (m *MailEditor) GetTrainerMatchView() string {
+	if m.trainer == nil {
+		return ""
+	}
+
+	mark := func(otid uint32) string {
+		if m.trainer.IsOriginalTrainer(otid) {
+			return RibbonCheckEnumStyle.Render(CheckMark)
+		}
+		return RibbonXMarkEnumStyle.Render(XMark)
+	}
+
+	return fmt.Sprintf(
+		"OT %s %05d: base %s result %s",
+		m.trainer.Name,
+		m.trainer.TID,
+		mark(m.pks.OTID),
+		mark(m.pksNew.OTID),
+	)
 }
 
 func (m *MailEditor) SwapEdits() {
@@ -78,15 +127,52 @@ func (m *MailEditor) GetEditMonView() string {
 	return generateMonViewOrder(&m.pks)
 }
 
+// In the chain view the result pane follows the selected step
+func (m *MailEditor) resultMon() *pokemon.PStructure {
+	if i, ok := m.chain.Selected(); ok && m.view == ChainMode {
+		return &m.chain.states[i].Mon
+	}
+	return &m.pksNew
+}
+
 func (m *MailEditor) GetResultMonView() string {
-	return generateMonViewOrder(&m.pksNew)
+	pk, c := m.resultMon(), m.badEgg
+
+	if i, ok := m.chain.Selected(); ok && m.view == ChainMode {
+		c = m.chain.states[i].Check
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		generateMonDiffViewOrder(pk, &m.pks),
+		getBadEggView(c),
+	)
+}
+
+func getBadEggView(c pokemon.BadEggCheck) string {
+
+	switch {
+	case c.Flagged:
+		return RibbonXMarkEnumStyle.Render(XMark + " Already flagged as a Bad Egg")
+	case c.WillBeBadEgg():
+		return RibbonXMarkEnumStyle.Render(fmt.Sprintf(
+			"%s BAD EGG: stored checksum %04X, game computes %04X", XMark, c.Stored, c.Computed,
+		))
+	}
+
+	return RibbonCheckEnumStyle.Render(fmt.Sprintf("%s Checksum %04X holds", CheckMark, c.Stored))
 }
 
 func (m *MailEditor) SaveMonToFile() error {
 	d, n := m.pks.GetSpecies()
 	f := fmt.Sprintf("%03d-%s-%08X.pk3", d, n, m.pks.PID)
 
-	err := os.WriteFile(f, m.pks.ToPK3(), 0644)
+	pk, err := m.pks.ToPK3()
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(f, pk, 0644)
 	if err != nil {
 		return err
 	}
@@ -94,10 +180,18 @@ func (m *MailEditor) SaveMonToFile() error {
 	return nil
 }
 
+// The result is shown the way the game will see it next time it reads
+// the mon, Bad Egg flag included
 func (m *MailEditor) CommitEdits() {
-	r := m.pks.SaveWithMail(m.GenerateNewPIDOTValues())
+	r, err := m.pks.SaveWithMail(m.GenerateNewPIDOTValues())
+	if m.err = err; err != nil {
+		return
+	}
+
 	n := pokemon.GeneratePokemonFromRawData(r, false, false)
+	c := n.SimulateDecrypt()
 	m.SetNewPokemon(n)
+	m.badEgg = c
 }
 
 func (m *MailEditor) UpdateValues() {
@@ -129,21 +223,27 @@ func (m *MailEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case updateMail:
 		m.SetNewPokemon(msg.pk)
 
+	case mailWordsMsg:
+		m.LoadSolution(pokemon.MailSolution{Words: msg.words})
+
+	case searchHitMsg, searchDoneMsg, searchTickMsg:
+		return m, m.search.Update(msg)
+
 	case tea.KeyMsg:
 		if key.Matches(msg, m.keys.Help) {
 			m.help.ShowAll = !m.help.ShowAll
 		}
 
 		if key.Matches(msg, m.keys.View) {
-			if m.view == MailMode {
-				m.view = SwapMode
-			} else {
-				m.view = MailMode
+			m.view = (m.view + 1) % (DiffMode + 1)
+			if m.view == ChainMode {
+				m.refreshChain()
 			}
 			return m, nil
 		}
 
-		if m.view == mailEdit {
+		switch m.view {
+		case MailMode:
 			switch {
 			case key.Matches(msg, m.keys.Swap):
 				m.SwapEdits()
@@ -208,7 +308,7 @@ func (m *MailEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, tea.Batch(cmds...)
 			}
-		} else {
+		case SwapMode:
 			if key.Matches(msg, m.keys.Commit) {
 				i := m.swaps.SelectedRow()[3]
 				b := m.swaps.SelectedRow()[4]
@@ -219,6 +319,86 @@ func (m *MailEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.words[j] = b
 				}
 			}
+
+		case SolveMode:
+			switch {
+			case key.Matches(msg, m.keys.Commit):
+				m.solver.Solve(&m.pks)
+				return m, nil
+			case key.Matches(msg, m.keys.Mode):
+				m.solver.postE4 = !m.solver.postE4
+				return m, nil
+			case key.Matches(msg, m.keys.Load):
+				if sol, ok := m.solver.Selected(); ok {
+					m.LoadSolution(sol)
+				}
+				return m, nil
+			case key.Matches(msg, m.keys.Up), key.Matches(msg, m.keys.Down):
+				var cmd tea.Cmd
+				m.solver.table, cmd = m.solver.table.Update(msg)
+				return m, cmd
+			}
+
+			var cmd tea.Cmd
+			m.solver.input, cmd = m.solver.input.Update(msg)
+			return m, cmd
+
+		case ChainMode:
+			var err error
+
+			switch {
+			case key.Matches(msg, m.keys.Commit):
+				m.AddChainStep(pokemon.ChainMail)
+			case key.Matches(msg, m.keys.Move):
+				m.AddChainStep(pokemon.ChainMove)
+			case key.Matches(msg, m.keys.Edit):
+				err = m.UpdateChainStep()
+			case key.Matches(msg, m.keys.Remove):
+				err = m.RemoveChainStep()
+			case key.Matches(msg, m.keys.Load):
+				m.LoadChainStep()
+			case key.Matches(msg, m.keys.Export):
+				f, err := m.ExportChain()
+				stat := "Wrote chain recipe to " + f
+				if err != nil {
+					stat = fmt.Sprintf("Unable to export chain: %v", err)
+				}
+				return m, func() tea.Msg { return statusMsg{stat: stat} }
+			case key.Matches(msg, m.keys.Up), key.Matches(msg, m.keys.Down):
+				var cmd tea.Cmd
+				m.chain.table, cmd = m.chain.table.Update(msg)
+				return m, cmd
+			}
+
+			if err != nil {
+				return m, func() tea.Msg { return statusMsg{stat: err.Error()} }
+			}
+			return m, nil
+
+		case BruteMode:
+			switch {
+			case key.Matches(msg, m.keys.Commit):
+				return m, m.search.Start(m.pks)
+			case key.Matches(msg, m.keys.Remove):
+				m.search.Cancel()
+				return m, nil
+			case key.Matches(msg, m.keys.Mode):
+				m.search.postE4 = !m.search.postE4
+				return m, nil
+			case key.Matches(msg, m.keys.Load):
+				if sol, ok := m.search.Selected(); ok {
+					m.LoadSolution(sol)
+				}
+				return m, nil
+			case key.Matches(msg, m.keys.Up), key.Matches(msg, m.keys.Down):
+				var cmd tea.Cmd
+				m.search.table, cmd = m.search.table.Update(msg)
+				return m, cmd
+			}
+
+			var cmd tea.Cmd
+			m.search.input, cmd = m.search.input.Update(msg)
+			return m, cmd
 		}
 	}
 
@@ -307,6 +487,22 @@ func (m *MailEditor) View() string {
 		Bold(false)
 
 	var editorJoin string
+	bottom := m.swaps.View()
+
+	switch m.view {
+	case SolveMode:
+		bottom = m.solver.View()
+	case ChainMode:
+		bottom = m.chain.View()
+	case BruteMode:
+		bottom = m.search.View()
+	case DiffMode:
+		bottom = makeDiffView(pokemon.DiffMons(&m.pks, m.resultMon()))
+	}
+
+	if m.err != nil {
+		bottom = lipgloss.JoinVertical(lipgloss.Center, RibbonXMarkEnumStyle.Render(m.err.Error()), bottom)
+	}
 
 	if m.view == MailMode {
 		editorJoin = MailMenuStyle.Render(editBase)
@@ -322,6 +518,9 @@ func (m *MailEditor) View() string {
 
 tableStyle:
 	m.swaps.SetStyles(s)
+	m.solver.table.SetStyles(s)
+	m.chain.table.SetStyles(s)
+	m.search.table.SetStyles(s)
 
 	res := lipgloss.JoinVertical(
 		lipgloss.Center,
@@ -334,7 +533,8 @@ tableStyle:
 			fmt.Sprintf("OTID: %08X", newOTID),
 			fmt.Sprintf("XKEY: %08X", xkey),
 		)),
-		m.swaps.View(),
+		m.GetTrainerMatchView(),
+		bottom,
 	)
 
 	edit := m.GetEditMonView()
@@ -407,6 +607,9 @@ func NewMailEditor() *MailEditor {
 		words:           BlankWordValues,
 		view:            MailMode,
 		swaps:           makeQuickSwapTable(),
+		solver:          newMailSolver(),
+		chain:           newMailChain(),
+		search:          newMailSearch(),
 		mode:            AllWords,
 		keys:            &MailKeys,
 	}
@@ -590,14 +793,32 @@ func makeMiscSubstructreView(pks *pokemon.PStructure, s string) string {
 }
 
 func generateMonViewOrder(pks *pokemon.PStructure) string {
+	return generateMonDiffViewOrder(pks, nil)
+}
+
+// Same as generateMonViewOrder with the substruct bytes that differ from
+// base highlighted, nothing is highlighted without a base
+func generateMonDiffViewOrder(pks, base *pokemon.PStructure) string {
 	order := pks.GetMonSubStructOrder()
 	subs := pks.GetSubstructDataInOrder(false)
 
+	raw := make([]string, len(subs))
+	for i := range subs {
+		raw[i] = subStructArray2String(subs[i])
+	}
+
+	if base != nil {
+		baseSubs := base.GetSubstructDataInOrder(false)
+		for i := range subs {
+			raw[i] = subStructDiffString(subs[i], baseSubs[i])
+		}
+	}
+
 	subViews := map[int]string{
-		0: makeGrowthSubStructView(pks, subStructArray2String(subs[0])),
-		1: makeAttacksSubStructView(pks, subStructArray2String(subs[1])),
-		2: makeConditionsSubStructView(pks, subStructArray2String(subs[2])),
-		3: makeMiscSubstructreView(pks, subStructArray2String(subs[3])),
+		0: makeGrowthSubStructView(pks, raw[0]),
+		1: makeAttacksSubStructView(pks, raw[1]),
+		2: makeConditionsSubStructView(pks, raw[2]),
+		3: makeMiscSubstructreView(pks, raw[3]),
 	}
 
 	var out []string
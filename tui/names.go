@@ -0,0 +1,178 @@
+package tui
+
+import (
+	"fmt"
+	"postal/pokemon"
+	"postal/utils"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var NameFieldNames = []string{"Nickname", "OT Name"}
+
+var nameFieldSizes = []int{pokemon.NickNameMaxLength, pokemon.TrainerNameMaxLength}
+
+// NameEditor edits names as the escaped text the codec decodes them to,
+// so control codes and bytes past the terminator can be typed back in
+type NameEditor struct {
+	*BaseEditorModel
+	errs []error
+	keys *EditorKeyMap
+}
+
+func (m *NameEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+
+	case tea.KeyMsg:
+
+		switch {
+		case key.Matches(msg, m.keys.Commit):
+			m.CommitEdits()
+			return m, nil
+
+		case key.Matches(msg, m.keys.Reset):
+			m.UpdateValues()
+			return m, nil
+
+		case key.Matches(msg, m.keys.Help):
+			m.help.ShowAll = !m.help.ShowAll
+
+		// Left and right stay with the input so escapes can be edited
+		case key.Matches(msg, m.keys.Up), key.Matches(msg, m.keys.Down):
+			m.focusIndex = (m.focusIndex + 1) % len(m.inputs)
+
+			cmds := make([]tea.Cmd, len(m.inputs))
+			for i := range m.inputs {
+				if i == m.focusIndex {
+					cmds[i] = m.inputs[i].Focus()
+					m.inputs[i].PromptStyle = FocusedStyle
+					m.inputs[i].TextStyle = FocusedText
+					continue
+				}
+				m.inputs[i].Blur()
+				m.inputs[i].PromptStyle = lipgloss.NewStyle()
+				m.inputs[i].TextStyle = lipgloss.NewStyle().Foreground(SubText)
+			}
+			return m, tea.Batch(cmds...)
+		}
+	}
+
+	return m, m.UpdateInputs(msg)
+}
+
+func (m *NameEditor) names() []*string {
+	return []*string{&m.pks.NickName, &m.pks.OTName}
+}
+
+func (m *NameEditor) encode(i int) ([]byte, error) {
+	return utils.EncodeText(m.inputs[i].Value(), nameFieldSizes[i], m.pks.Lang)
+}
+
+func hexBytes(bs []byte) string {
+	s := make([]string, len(bs))
+	for i, v := range bs {
+		s[i] = fmt.Sprintf("%02X", v)
+	}
+	return strings.Join(s, " ")
+}
+
+func nameRow(f, v string) string {
+	return lipgloss.JoinHorizontal(
+		lipgloss.Center,
+		GrowthFieldStyle.Render(f),
+		GrowthValueStyle.UnsetWidth().Render(v),
+	)
+}
+
+func (m *NameEditor) makeFieldView(i int) string {
+	var raw string
+	shown := blank
+
+	if b, err := m.encode(i); err != nil {
+		raw = RibbonXMarkEnumStyle.Render(err.Error())
+	} else {
+		raw = hexBytes(b)
+		shown = utils.CleanText(utils.DecodeText(b, m.pks.Lang))
+	}
+
+	if m.errs[i] != nil {
+		raw = RibbonXMarkEnumStyle.Render(m.errs[i].Error())
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		RibbonSumStyle.Render(NameFieldNames[i]),
+		WordEntryStyle.Render(m.inputs[i].View()),
+		nameRow("Bytes", raw),
+		nameRow("Shown", shown),
+		" ",
+	)
+}
+
+func (m *NameEditor) View() string {
+	_, lang := m.pks.GetLanguage()
+
+	fields := make([]string, len(m.inputs))
+	for i := range m.inputs {
+		fields[i] = m.makeFieldView(i)
+	}
+
+	return MenuStyle.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		nameRow("Language", lang),
+		nameRow("Escapes", `[FC 01] for raw bytes, \ ends`),
+		" ",
+		lipgloss.JoinVertical(lipgloss.Left, fields...),
+	))
+}
+
+func (m *NameEditor) SetPokemon(pks pokemon.PStructure) {
+	m.pks = pks
+	m.UpdateValues()
+}
+
+func (m *NameEditor) UpdateValues() {
+	for i, n := range m.names() {
+		m.inputs[i].SetValue(*n)
+		m.errs[i] = nil
+	}
+}
+
+// Names are stored the way they decode so the mon holds them exactly
+func (m *NameEditor) CommitEdits() {
+	names := m.names()
+
+	for i := range m.inputs {
+		b, err := m.encode(i)
+		if m.errs[i] = err; err != nil {
+			continue
+		}
+
+		*names[i] = utils.DecodeText(b, m.pks.Lang)
+		m.inputs[i].SetValue(*names[i])
+	}
+}
+
+func (m *NameEditor) GetKeys() string {
+	return m.help.View(m.keys)
+}
+
+func NewNameEditor() *NameEditor {
+	inputs := makeEditorTextModels(NameFieldNames)
+	for i := range inputs {
+		inputs[i].Width = 40
+	}
+
+	return &NameEditor{
+		BaseEditorModel: &BaseEditorModel{
+			inputs: inputs,
+			help:   help.New(),
+		},
+		errs: make([]error, len(NameFieldNames)),
+		keys: &EditorKeys,
+	}
+}
@@ -0,0 +1,151 @@
+package tui
+
+import (
+	"fmt"
+	"postal/pokemon"
+	"postal/save"
+	"strconv"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type partySelect struct {
+	table table.Model
+	help  help.Model
+	keys  *BoxViewKeyMap
+	mons  []pokemon.PStructure
+	pks   pokemon.PStructure
+}
+
+var partyCols = []table.Column{
+	{Title: "Slot", Width: 5},
+	{Title: "Species", Width: 10},
+	{Title: "Lvl", Width: 4},
+	{Title: "HP", Width: 9},
+	{Title: "Status", Width: 7},
+	{Title: "Atk", Width: 4},
+	{Title: "Def", Width: 4},
+	{Title: "Spe", Width: 4},
+	{Title: "SpA", Width: 4},
+	{Title: "SpD", Width: 4},
+}
+
+func (m partySelect) Init() tea.Cmd { return nil }
+
+func (m partySelect) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Help):
+			m.help.ShowAll = !m.help.ShowAll
+
+		case key.Matches(msg, m.keys.Enter):
+			if i, ok := m.selectedSlot(); ok {
+				m.pks = m.mons[i]
+				return m, returnToMain(m.pks, monSlot{index: i, party: true})
+			}
+		}
+	}
+
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+func (m partySelect) View() string {
+	s := fmt.Sprintf(" Party (%d/%d)", len(m.mons), save.PartyMax)
+	o := lipgloss.JoinVertical(lipgloss.Left, s, baseStyle.Render(m.table.View()))
+
+	if i, ok := m.selectedSlot(); ok {
+		p := generateMonViewOrder(&m.mons[i])
+		return lipgloss.JoinVertical(
+			lipgloss.Center,
+			lipgloss.JoinHorizontal(lipgloss.Center, o, p),
+			m.help.View(m.keys),
+		)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, o, m.help.View(m.keys))
+}
+
+func (m *partySelect) selectedSlot() (int, bool) {
+	row := m.table.SelectedRow()
+	if len(row) == 0 {
+		return 0, false
+	}
+
+	i, err := strconv.Atoi(row[0])
+	if err != nil || i < 1 || i > len(m.mons) {
+		return 0, false
+	}
+
+	return i - 1, true
+}
+
+func NewPartySelect(t save.RawTeamAndItems) partySelect {
+	mons := make([]pokemon.PStructure, t.GetTeamSize())
+	for i := range mons {
+		mons[i] = pokemon.GeneratePokemonFromRawData(t.GetPartyMonData(i), false, false)
+	}
+
+	return partySelect{
+		help:  help.New(),
+		keys:  &BoxKeys,
+		table: makeTableFromParty(mons),
+		mons:  mons,
+	}
+}
+
+func partyMonToTableRow(s string, p pokemon.PStructure) table.Row {
+	_, species := p.GetSpecies()
+	_, status := p.GetStatusCondition()
+
+	return table.Row{
+		s,
+		species,
+		fmt.Sprintf("%d", p.Level),
+		fmt.Sprintf("%d/%d", p.CurHP, p.TotalHP),
+		status,
+		fmt.Sprintf("%d", p.Atk),
+		fmt.Sprintf("%d", p.Def),
+		fmt.Sprintf("%d", p.Spe),
+		fmt.Sprintf("%d", p.Spa),
+		fmt.Sprintf("%d", p.Spd),
+	}
+}
+
+func makeTableFromParty(mons []pokemon.PStructure) table.Model {
+	rows := []table.Row{}
+
+	for i := range mons {
+		rows = append(rows, partyMonToTableRow(fmt.Sprintf("%d", i+1), mons[i]))
+	}
+
+	t := table.New(
+		table.WithColumns(partyCols),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(save.PartyMax+1),
+	)
+
+	s := table.DefaultStyles()
+
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		BorderBottom(true).
+		Bold(false)
+
+	s.Selected = s.Selected.
+		Foreground(RegularText).
+		Background(DarkPurple).
+		Bold(false)
+
+	t.SetStyles(s)
+
+	return t
+}
@@ -0,0 +1,321 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"postal/pokemon"
+	"postal/utils"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// Rows shown from the search, the rest are only counted
+	searchRowLimit = 500
+
+	// Hits sent to the editor in one message at most
+	searchBatch = 256
+
+	searchTick = 200 * time.Millisecond
+)
+
+var searchCols = []table.Column{
+	{Title: "PIDHI", Width: 10},
+	{Title: "PIDLO", Width: 10},
+	{Title: "SID", Width: 10},
+	{Title: "TID", Width: 10},
+	{Title: "Sets", Width: 8},
+	{Title: "Result", Width: 12},
+	{Title: "Egg", Width: 3},
+}
+
+// Search messages carry the id of the search that sent them so a
+// cancelled search can't leak into the next one
+type (
+	searchHitMsg struct {
+		id   int
+		hits []pokemon.SearchHit
+	}
+
+	searchDoneMsg struct {
+		id  int
+		err error
+	}
+
+	searchTickMsg struct{ id int }
+)
+
+type mailSearch struct {
+	input  textinput.Model
+	table  table.Model
+	postE4 bool
+
+	id      int
+	search  *pokemon.MailSearch
+	hits    chan pokemon.SearchHit
+	done    chan error
+	cancel  context.CancelFunc
+	running bool
+	started time.Time
+	elapsed time.Duration
+
+	found int
+	sets  int
+	shown []pokemon.MailSolution
+	err   error
+}
+
+func newMailSearch() mailSearch {
+	ti := textinput.New()
+	ti.Placeholder = "species Mew, item 0x44, valid"
+	ti.Cursor.Style = lipgloss.NewStyle().Foreground(LightPink)
+	ti.CharLimit = 96
+	ti.Width = 48
+	ti.Focus()
+
+	t := table.New(
+		table.WithColumns(searchCols),
+		table.WithFocused(true),
+		table.WithHeight(8),
+	)
+
+	return mailSearch{input: ti, table: t}
+}
+
+// Targets are solver targets split by commas, "valid" only keeps the
+// results the game won't turn into a Bad Egg
+func parseSearchInput(s string) ([]pokemon.MailTarget, bool, error) {
+	var targets []pokemon.MailTarget
+	valid := false
+
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == "":
+			continue
+		case strings.EqualFold(part, "valid"):
+			valid = true
+			continue
+		}
+
+		f, v, err := parseSolverTarget(part)
+		if err != nil {
+			return nil, false, err
+		}
+		targets = append(targets, pokemon.MailTarget{Field: f, Value: v})
+	}
+
+	if len(targets) == 0 {
+		return nil, false, utils.ErrNoSearchTarget
+	}
+
+	return targets, valid, nil
+}
+
+// The editor reads a zero word as "keep the mon's value", so the row
+// shows the first word set without one
+func loadableSolution(h *pokemon.SearchHit) (pokemon.MailSolution, bool) {
+	for sol := range h.Solutions() {
+		if !slices.Contains(sol.Words[:], 0) {
+			return sol, true
+		}
+	}
+	return pokemon.MailSolution{}, false
+}
+
+func (s *mailSearch) Start(base pokemon.PStructure) tea.Cmd {
+	s.Cancel()
+
+	s.found, s.sets = 0, 0
+	s.shown = nil
+	s.table.SetRows(nil)
+
+	targets, valid, err := parseSearchInput(s.input.Value())
+	if s.err = err; err != nil {
+		s.search = nil
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s.id++
+	s.cancel = cancel
+	s.running = true
+	s.started = time.Now()
+	s.search = &pokemon.MailSearch{
+		Base:      base,
+		Targets:   targets,
+		ValidOnly: valid,
+		PostE4:    s.postE4,
+	}
+	s.hits = make(chan pokemon.SearchHit, searchBatch)
+	s.done = make(chan error, 1)
+
+	search, hits, done := s.search, s.hits, s.done
+	go func() { done <- search.Run(ctx, hits) }()
+
+	return tea.Batch(s.wait(), s.tick())
+}
+
+func (s *mailSearch) Cancel() {
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+}
+
+// wait hands the next hits to the editor, taking whatever else is
+// already queued so a busy search doesn't redraw once per hit
+func (s *mailSearch) wait() tea.Cmd {
+	id, hits, done := s.id, s.hits, s.done
+
+	return func() tea.Msg {
+		h, ok := <-hits
+		if !ok {
+			return searchDoneMsg{id: id, err: <-done}
+		}
+
+		batch := []pokemon.SearchHit{h}
+		for len(batch) < searchBatch {
+			select {
+			case h, ok := <-hits:
+				if !ok {
+					return searchHitMsg{id: id, hits: batch}
+				}
+				batch = append(batch, h)
+			default:
+				return searchHitMsg{id: id, hits: batch}
+			}
+		}
+
+		return searchHitMsg{id: id, hits: batch}
+	}
+}
+
+func (s *mailSearch) tick() tea.Cmd {
+	id := s.id
+	return tea.Tick(searchTick, func(time.Time) tea.Msg { return searchTickMsg{id: id} })
+}
+
+func (s *mailSearch) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case searchHitMsg:
+		if msg.id != s.id {
+			return nil
+		}
+		s.add(msg.hits)
+		return s.wait()
+
+	case searchDoneMsg:
+		if msg.id != s.id {
+			return nil
+		}
+		s.running = false
+		s.elapsed = time.Since(s.started)
+		s.Cancel()
+
+		if !errors.Is(msg.err, context.Canceled) {
+			s.err = msg.err
+		}
+
+	case searchTickMsg:
+		if msg.id == s.id && s.running {
+			return s.tick()
+		}
+	}
+
+	return nil
+}
+
+func (s *mailSearch) add(hits []pokemon.SearchHit) {
+	rows := s.table.Rows()
+
+	for i := range hits {
+		h := &hits[i]
+		s.found++
+		s.sets += h.Count
+
+		if len(s.shown) == searchRowLimit {
+			continue
+		}
+
+		sol, ok := loadableSolution(h)
+		if !ok {
+			continue
+		}
+
+		egg := CheckMark
+		if h.Check.WillBeBadEgg() {
+			egg = XMark
+		}
+		_, species := h.Mon.GetSpecies()
+
+		s.shown = append(s.shown, sol)
+		rows = append(rows, table.Row{
+			mailWordName(sol.Words[pokemon.MailPIDHI]),
+			mailWordName(sol.Words[pokemon.MailPIDLO]),
+			mailWordName(sol.Words[pokemon.MailSID]),
+			mailWordName(sol.Words[pokemon.MailTID]),
+			fmt.Sprintf("%d", h.Count),
+			species,
+			egg,
+		})
+	}
+
+	s.table.SetRows(rows)
+}
+
+func (s *mailSearch) Selected() (pokemon.MailSolution, bool) {
+	i := s.table.Cursor()
+	if i < 0 || i >= len(s.shown) {
+		return pokemon.MailSolution{}, false
+	}
+	return s.shown[i], true
+}
+
+func (s *mailSearch) View() string {
+	words := "pre E4 words"
+	if s.postE4 {
+		words = "post E4 words"
+	}
+
+	status := words
+	switch {
+	case s.err != nil:
+		status = RibbonXMarkEnumStyle.Render(s.err.Error())
+
+	case s.search != nil:
+		done, total := s.search.Progress()
+		pct := 100.0
+		if total > 0 {
+			pct = 100 * float64(done) / float64(total)
+		}
+
+		state := fmt.Sprintf("done in %s", s.elapsed.Round(time.Millisecond))
+		switch {
+		case s.running:
+			state = "searching"
+		case done < total:
+			state = "cancelled"
+		}
+
+		status = fmt.Sprintf(
+			"%s %d/%d keys (%.0f%%): %d hits, %d word sets, %s",
+			state, done, total, pct, s.found, s.sets, words,
+		)
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		WordEntryStyle.Render(s.input.View()),
+		status,
+		s.table.View(),
+	)
+}
@@ -0,0 +1,211 @@
+package tui
+
+import (
+	"fmt"
+	vals "postal/game"
+	"postal/pokemon"
+	"postal/utils"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Rows shown from the solver, the rest are only counted
+const solverRowLimit = 200
+
+// Same order as pokemon.MailFields
+var SolverFieldNames = []string{
+	"species", "item", "move1", "move2", "move3", "move4", "evs1", "evs2", "evs3",
+}
+
+// Which vals slot each mail input writes to
+var mailInputSlots = []int{
+	pokemon.MailPIDLO, pokemon.MailPIDHI, pokemon.MailTID, pokemon.MailSID,
+}
+
+var solverCols = []table.Column{
+	{Title: "PIDHI", Width: 12},
+	{Title: "PIDLO", Width: 12},
+	{Title: "SID", Width: 12},
+	{Title: "TID", Width: 12},
+}
+
+type mailSolver struct {
+	input     textinput.Model
+	table     table.Model
+	postE4    bool
+	solutions *pokemon.MailSolutions
+	shown     []pokemon.MailSolution
+	err       error
+}
+
+func newMailSolver() mailSolver {
+	ti := textinput.New()
+	ti.Placeholder = "species Mew / item 0x44 / move2 Psychic / evs1 0x97"
+	ti.Cursor.Style = lipgloss.NewStyle().Foreground(LightPink)
+	ti.CharLimit = 64
+	ti.Width = 48
+	ti.Focus()
+
+	t := table.New(
+		table.WithColumns(solverCols),
+		table.WithFocused(true),
+		table.WithHeight(8),
+	)
+
+	return mailSolver{input: ti, table: t}
+}
+
+// Targets are written as "<field> <value>" with the field from
+// SolverFieldNames. Values can be names or numbers
+func parseSolverTarget(s string) (pokemon.MailField, uint16, error) {
+	f, v, _ := strings.Cut(strings.TrimSpace(s), " ")
+	i := slices.Index(SolverFieldNames, strings.ToLower(f))
+	v = strings.TrimSpace(v)
+
+	if i < 0 {
+		return pokemon.MailField{}, 0, fmt.Errorf("unknown target %q", f)
+	}
+
+	field := pokemon.MailFields[i]
+
+	var n uint16
+	var err error
+
+	switch {
+	case i == 0:
+		n, err = parseSpeciesInput(v)
+	case i == 1:
+		n, err = parseItemInput(v)
+	case i <= 5:
+		n, err = parseMoveInput(v)
+	default:
+		var u uint64
+		u, err = strconv.ParseUint(v, 0, 16)
+		n = uint16(u)
+	}
+
+	if err != nil {
+		return field, 0, utils.ErrOutOfRange
+	}
+
+	return field, n, nil
+}
+
+// Moves can be entered by name or by their index
+func parseMoveInput(s string) (uint16, error) {
+	if mv, err := vals.MoveLookup(utils.SanitizeSearch(s)); err == nil {
+		return uint16(mv), nil
+	}
+
+	n, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return 0, utils.ErrOutOfRange
+	}
+
+	return uint16(n), nil
+}
+
+func mailWordName(w uint16) string {
+	if n, ok := vals.MergedWordMap[w]; ok {
+		return n
+	}
+	return fmt.Sprintf("%04X", w)
+}
+
+func (s *mailSolver) Solve(pks *pokemon.PStructure) {
+	s.solutions = nil
+	s.shown = nil
+	s.table.SetRows(nil)
+
+	f, target, err := parseSolverTarget(s.input.Value())
+	if s.err = err; err != nil {
+		return
+	}
+
+	// The editor reads a zero word as "keep the mon's value" so it can't
+	// be loaded back in
+	words := slices.DeleteFunc(vals.MailWords(s.postE4), func(w uint16) bool {
+		return w == 0
+	})
+
+	s.solutions = pokemon.SolveMail(pks, f, target, words)
+
+	var rows []table.Row
+	for sol := range s.solutions.All() {
+		if len(s.shown) == solverRowLimit {
+			break
+		}
+
+		s.shown = append(s.shown, sol)
+		rows = append(rows, table.Row{
+			mailWordName(sol.Words[pokemon.MailPIDHI]),
+			mailWordName(sol.Words[pokemon.MailPIDLO]),
+			mailWordName(sol.Words[pokemon.MailSID]),
+			mailWordName(sol.Words[pokemon.MailTID]),
+		})
+	}
+
+	s.table.SetRows(rows)
+	s.table.SetCursor(0)
+}
+
+func (s *mailSolver) Selected() (pokemon.MailSolution, bool) {
+	i := s.table.Cursor()
+	if i < 0 || i >= len(s.shown) {
+		return pokemon.MailSolution{}, false
+	}
+	return s.shown[i], true
+}
+
+func (s *mailSolver) View() string {
+	words := "pre E4 words"
+	if s.postE4 {
+		words = "post E4 words"
+	}
+
+	status := words
+	switch {
+	case s.err != nil:
+		status = RibbonXMarkEnumStyle.Render(s.err.Error())
+	case s.solutions != nil:
+		status = fmt.Sprintf(
+			"%s = 0x%04X: %d solutions, %s",
+			s.solutions.Field.Name, s.solutions.Target, s.solutions.Count(), words,
+		)
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		WordEntryStyle.Render(s.input.View()),
+		status,
+		s.table.View(),
+	)
+}
+
+// LoadSolution writes the solution into the word inputs. The search mode
+// is switched so the focused input looks its word up in the right list
+func (m *MailEditor) LoadSolution(sol pokemon.MailSolution) {
+	for i, slot := range mailInputSlots {
+		w := sol.Words[slot]
+		m.inputs[i].SetValue(mailWordName(w))
+		m.vals[slot] = uint(w)
+		m.words[slot] = fmt.Sprintf("%04X", w)
+	}
+
+	focused := sol.Words[mailInputSlots[m.focusIndex]]
+	switch {
+	case vals.Pokemon1Map[focused] != "":
+		m.mode = WordMonOne
+	case vals.Pokemon2Map[focused] != "":
+		m.mode = WordMonTwo
+	default:
+		m.mode = AllWords
+	}
+
+	m.CommitEdits()
+}
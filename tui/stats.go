@@ -14,7 +14,7 @@ import (
 
 type StatEditor struct {
 	*BaseEditorModel
-	keys *EditorKeyMap
+	keys *StatKeyMap
 }
 
 var (
@@ -31,6 +31,12 @@ func (m *StatEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, m.keys.Commit):
 			m.CommitEdits()
 
+		case key.Matches(msg, m.keys.Recalc):
+			m.pks.RecalculateStats()
+
+		case key.Matches(msg, m.keys.RecalcClamp):
+			m.pks.RecalculateStatsClampHP()
+
 		case key.Matches(msg, m.keys.Reset):
 			m.UpdateValues()
 			m.inputs[m.focusIndex].Reset()
@@ -130,7 +136,7 @@ func (m *StatEditor) View() string {
 		"",
 		edit,
 		"",
-		view,
+		lipgloss.JoinHorizontal(lipgloss.Top, view, "  ", makePartyStatsView(&m.pks)),
 	)
 }
 
@@ -167,7 +173,7 @@ func NewStatEditor() *StatEditor {
 			vals:   make([]uint, len(ExpandedStatNames)),
 			help:   help.New(),
 		},
-		keys: &EditorKeys,
+		keys: &StatKeys,
 	}
 }
 
@@ -221,3 +227,46 @@ func makeFullStatsView(pks *pk.PStructure) string {
 		StatsWrapperStyle.Render(out),
 	)
 }
+
+func partyStatValues(s pk.PartyStats) []uint16 {
+	return []uint16{uint16(s.Level), s.HP, s.Atk, s.Def, s.Spe, s.SpA, s.SpD}
+}
+
+// Stored party stats next to the ones the game would work out, rows that
+// differ are marked
+func makePartyStatsView(pks *pk.PStructure) string {
+	stored := partyStatValues(pks.StoredStats())
+	computed := partyStatValues(pks.ComputeStats())
+	names := append([]string{"Level"}, StatNames...)
+
+	rows := []string{lipgloss.JoinHorizontal(
+		lipgloss.Center,
+		ConditionFieldStyle.Render(""),
+		ConditionValueStyle.Render("Now"),
+		ConditionValueStyle.Render("Calc"),
+	)}
+
+	for i := range names {
+		rows = append(rows, lipgloss.JoinHorizontal(
+			lipgloss.Center,
+			ConditionFieldStyle.Render(names[i]),
+			ConditionValueStyle.Render(fmt.Sprintf("%d", stored[i])),
+			ConditionValueStyle.Render(fmt.Sprintf("%d", computed[i])),
+			integrityMark(stored[i] == computed[i]),
+		))
+	}
+
+	state := RibbonCheckEnumStyle.Render("Stats match")
+	if !pks.StatsMatch() {
+		state = RibbonXMarkEnumStyle.Render("Stats mismatch")
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		RibbonSumStyle.Render(fmt.Sprintf("Party Stats - HP %d/%d", pks.CurHP, pks.TotalHP)),
+		" ",
+		lipgloss.JoinVertical(lipgloss.Left, rows...),
+		" ",
+		state,
+	)
+}
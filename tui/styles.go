@@ -82,7 +82,7 @@ var (
 	NickNameStyle = lipgloss.NewStyle().Background(DarkPurple).Foreground(RegularText).Padding(0, 1)
 	EditStyle     = lipgloss.NewStyle().Background(DarkerBlueish).Foreground(LightGreenish).Padding(0, 1)
 	OTNameStyle   = lipgloss.NewStyle().Background(DarkerPurple).Foreground(RegularText).Padding(0, 1)
-	edit          = []string{"Mail", "Growth", "Attacks", "EV&C", "Misc", "Stats", "Origin", "Ribbons", "Lookup"}
+	edit          = []string{"Mail", "Growth", "Attacks", "EV&C", "Misc", "Stats", "Origin", "Ribbons", "Names", "Hex", "Lookup"}
 )
 
 var (
@@ -0,0 +1,227 @@
+package tui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"postal/pokemon"
+	"postal/save"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Points at a shared template library instead of the config directory
+const TemplateDirEnv = "POSTAL_TEMPLATES"
+
+// Sidecar next to a user template, foo.json for foo.pk3
+type templateMeta struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Route       string `json:"route"`
+}
+
+type templateEntry struct {
+	pokemon.Template
+	route string
+
+	// Empty for the built in templates
+	path string
+}
+
+// Asks the main model to load a template into the editors
+type templateMsg struct {
+	name string
+	pk   pokemon.PStructure
+}
+
+func TemplateDir() (string, error) {
+	if d := os.Getenv(TemplateDirEnv); d != "" {
+		return d, nil
+	}
+
+	d, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(d, "postal", "templates"), nil
+}
+
+// Loads every .pk3 and .ek3 in dir along with its sidecar. A missing dir
+// just means no user templates, files that fail are skipped and reported
+func loadUserTemplates(dir string) ([]templateEntry, error) {
+	files, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var out []templateEntry
+	var errs []error
+
+	for _, f := range files {
+		ext := strings.ToLower(filepath.Ext(f.Name()))
+		if f.IsDir() || (ext != ".pk3" && ext != ".ek3") {
+			continue
+		}
+
+		p := filepath.Join(dir, f.Name())
+		t, err := loadUserTemplate(p, ext == ".pk3")
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", f.Name(), err))
+			continue
+		}
+		out = append(out, t)
+	}
+
+	return out, errors.Join(errs...)
+}
+
+func loadUserTemplate(p string, decrypted bool) (templateEntry, error) {
+	data, err := save.GetRawMonDataFromFile(p)
+	if err != nil {
+		return templateEntry{}, err
+	}
+
+	base := strings.TrimSuffix(p, filepath.Ext(p))
+	meta := templateMeta{Name: filepath.Base(base), Route: "user"}
+
+	b, err := os.ReadFile(base + ".json")
+	if err == nil {
+		err = json.Unmarshal(b, &meta)
+	}
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return templateEntry{}, err
+	}
+
+	return templateEntry{
+		Template: pokemon.Template{
+			Name:        meta.Name,
+			Description: meta.Description,
+			Data:        data,
+			Decrypted:   decrypted,
+		},
+		route: meta.Route,
+		path:  p,
+	}, nil
+}
+
+type templateList struct {
+	entries []templateEntry
+	dir     string
+	cursor  int
+	err     error
+	help    help.Model
+	keys    *TemplateKeyMap
+}
+
+func NewTemplateList() templateList {
+	m := templateList{
+		help: help.New(),
+		keys: &TemplateKeys,
+	}
+	m.reload()
+	return m
+}
+
+func (m *templateList) reload() {
+	m.entries = m.entries[:0]
+	for _, t := range pokemon.Templates {
+		m.entries = append(m.entries, templateEntry{Template: t, route: "built in"})
+	}
+
+	var user []templateEntry
+	m.dir, m.err = TemplateDir()
+	if m.err == nil {
+		user, m.err = loadUserTemplates(m.dir)
+	}
+
+	m.entries = append(m.entries, user...)
+	m.cursor = min(m.cursor, len(m.entries)-1)
+}
+
+func (m templateList) Init() tea.Cmd { return nil }
+
+func (m templateList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Help):
+			m.help.ShowAll = !m.help.ShowAll
+
+		case key.Matches(msg, m.keys.Up):
+			m.cursor = max(m.cursor-1, 0)
+
+		case key.Matches(msg, m.keys.Down):
+			m.cursor = min(m.cursor+1, len(m.entries)-1)
+
+		case key.Matches(msg, m.keys.Reload):
+			m.reload()
+
+		case key.Matches(msg, m.keys.Enter):
+			t := m.entries[m.cursor]
+			return m, func() tea.Msg { return templateMsg{name: t.Name, pk: t.Mon()} }
+		}
+	}
+	return m, nil
+}
+
+func (m templateList) makeInfoView() string {
+	t := m.entries[m.cursor]
+
+	desc := t.Description
+	if desc == "" {
+		desc = blank
+	}
+
+	rows := []string{
+		RibbonSumStyle.Render(t.Name),
+		" ",
+		lipgloss.NewStyle().Width(48).Render(desc),
+	}
+
+	if t.path != "" {
+		rows = append(rows, " ", KeyPair.Render(t.path))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+func (m templateList) View() string {
+	rows := []string{RibbonSumStyle.Render("Templates"), " "}
+
+	for i, t := range m.entries {
+		pk := t.Mon()
+		_, species := pk.GetSpecies()
+
+		style := lipgloss.NewStyle().Foreground(SubText)
+		if i == m.cursor {
+			style = FocusedText.Bold(true)
+		}
+		rows = append(rows, style.Render(fmt.Sprintf("%-12s %-12s %s", t.Name, species, t.route)))
+	}
+
+	status := "User templates: " + m.dir
+	if m.err != nil {
+		status = RibbonXMarkEnumStyle.Render(m.err.Error())
+	}
+	rows = append(rows, " ", m.makeInfoView(), " ", KeyPair.Render(status))
+
+	pk := m.entries[m.cursor].Mon()
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			MenuStyle.Align(lipgloss.Left).Render(lipgloss.JoinVertical(lipgloss.Left, rows...)),
+			generateMonViewOrder(&pk),
+		),
+		m.help.View(m.keys),
+	)
+}
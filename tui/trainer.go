@@ -0,0 +1,81 @@
+package tui
+
+import (
+	"fmt"
+	"postal/save"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	TrainerCardNames   = []string{"Game", "Name", "Gender", "TID", "SID", "OTID", "Play Time", "Key"}
+	TrainerOptionNames = []string{"Text Speed", "Frame", "Buttons", "Sound", "Style", "Scene"}
+)
+
+type trainerCard struct {
+	info save.TrainerInfo
+	help help.Model
+	keys *BoxViewKeyMap
+}
+
+func NewTrainerCard(info save.TrainerInfo) trainerCard {
+	return trainerCard{
+		info: info,
+		help: help.New(),
+		keys: &BoxKeys,
+	}
+}
+
+func (m trainerCard) Init() tea.Cmd { return nil }
+
+func (m trainerCard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if key.Matches(msg, m.keys.Help) {
+			m.help.ShowAll = !m.help.ShowAll
+		}
+	}
+	return m, nil
+}
+
+func (m trainerCard) View() string {
+	t := m.info
+	o := t.Options
+
+	card := lipgloss.JoinVertical(
+		lipgloss.Left,
+		joinGrowthFieldValueString(TrainerCardNames[0], t.Game.String()),
+		joinGrowthFieldValueString(TrainerCardNames[1], t.Name),
+		joinGrowthFieldValueString(TrainerCardNames[2], t.GetGender()),
+		joinGrowthFieldValueString(TrainerCardNames[3], fmt.Sprintf("%05d (0x%04X)", t.TID, t.TID)),
+		joinGrowthFieldValueString(TrainerCardNames[4], fmt.Sprintf("%05d (0x%04X)", t.SID, t.SID)),
+		joinGrowthFieldValueString(TrainerCardNames[5], fmt.Sprintf("%08X", t.GetOTID())),
+		joinGrowthFieldValueString(TrainerCardNames[6], fmt.Sprintf(
+			"%d:%02d:%02d", t.Time.Hours, t.Time.Minutes, t.Time.Seconds,
+		)),
+		joinGrowthFieldValueString(TrainerCardNames[7], fmt.Sprintf("%08X", t.Key)),
+	)
+
+	opts := lipgloss.JoinVertical(
+		lipgloss.Left,
+		joinGrowthFieldValueString(TrainerOptionNames[0], o.GetTextSpeed()),
+		joinGrowthFieldValueString(TrainerOptionNames[1], fmt.Sprintf("Type %d", o.Frame+1)),
+		joinGrowthFieldValueString(TrainerOptionNames[2], o.GetButtonMode()),
+		joinGrowthFieldValueString(TrainerOptionNames[3], o.GetSound()),
+		joinGrowthFieldValueString(TrainerOptionNames[4], o.GetBattleStyle()),
+		joinGrowthFieldValueString(TrainerOptionNames[5], o.GetBattleScene()),
+	)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			MenuStyle.Render(lipgloss.JoinVertical(lipgloss.Center, RibbonSumStyle.Render("Trainer"), " ", card)),
+			MenuStyle.Render(lipgloss.JoinVertical(lipgloss.Center, RibbonSumStyle.Render("Options"), " ", opts)),
+		),
+		m.help.View(m.keys),
+	)
+}
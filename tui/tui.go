@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"postal/boxes"
 	"postal/pokemon"
 	"postal/save"
+	"postal/utils"
+	"reflect"
 	"strings"
 	"time"
 
@@ -27,13 +30,29 @@ const (
 	statEdit
 	originEdit
 	ribbonEdit
+	nameEdit
+	hexEdit
 	searcher
 	boxView
 	picker
+	partyView
+	trainerView
+	bagView
+	gridView
+	checkView
+	batchView
+	templateView
+	historyView
+	aceView
 )
 
 type editorState int
 
+var (
+	errNoSaveSlot  = errors.New("active mon was not loaded from a save slot")
+	errNoSaveBlock = errors.New("neither save block could be loaded")
+)
+
 type EditorModel interface {
 	// Tea Model interface methods
 	tea.Model
@@ -111,8 +130,22 @@ type MainModel struct {
 
 	editors []EditorModel
 
-	searcher tea.Model
-	boxView  tea.Model
+	searcher    tea.Model
+	boxView     tea.Model
+	partyView   tea.Model
+	trainerView tea.Model
+	bagView     tea.Model
+	gridView    tea.Model
+	checkView   tea.Model
+	batchView   tea.Model
+	templates   tea.Model
+	historyView tea.Model
+	aceView     tea.Model
+
+	history *monHistory
+
+	save *saveFile
+	slot *monSlot
 
 	picker       filepicker.Model
 	selectedFile string
@@ -127,6 +160,58 @@ type MainModel struct {
 	width  int
 }
 
+// Loaded save data kept around so edited mons can be written back
+type saveFile struct {
+	path    string
+	raw     save.RawSaveFile
+	block   save.SaveBlock
+	storage *boxes.PCStorage
+}
+
+// Saves with bad checksums still load, the error is passed along so it
+// can be shown next to the boxes. An active block that can't be read at
+// all falls back to the backup the way the game does, and when neither
+// can be the save is kept anyway so its integrity can be checked
+func loadSaveFile(p string) (*saveFile, error) {
+	raw, err := save.GenerateRawSaveData(p)
+	if err != nil {
+		return nil, err
+	}
+
+	sf := &saveFile{path: p, raw: raw}
+	err = sf.loadBlock(false)
+	if err != nil && !errors.Is(err, utils.ErrSaveChecksum) {
+		sf.loadBlock(true)
+	}
+
+	return sf, err
+}
+
+// loaded is false when neither block could be read
+func (s *saveFile) loaded() bool {
+	return s.storage != nil
+}
+
+// Swaps in the active or backup block, dropping any unwritten box edits
+func (s *saveFile) loadBlock(backup bool) error {
+	var block save.SaveBlock
+	var err error
+
+	if backup {
+		block, err = s.raw.GenerateBackupSaveBlock()
+	} else {
+		block, err = s.raw.GenerateSaveBlock()
+	}
+
+	if err != nil && !errors.Is(err, utils.ErrSaveChecksum) {
+		return err
+	}
+
+	s.block = block
+	s.storage = boxes.GeneratePCStorage(block.GetRawBoxData())
+	return err
+}
+
 type clearErrorMsg struct{}
 
 type clearStatusMsg struct{}
@@ -163,13 +248,21 @@ func InitMainBlank() MainModel {
 	return InitMainEditor(blank)
 }
 
-func InitMainBoxData(b save.RawBoxDataTotal) MainModel {
+func InitMainSaveData(p string) MainModel {
 	blank := pokemon.GeneratePokemonFromRawData(pokemon.BlankSpecies, false, true)
 	m := InitMainEditor(blank)
 
+	sf, err := loadSaveFile(p)
+	m.err = err
+	if sf == nil {
+		m.state = picker
+		return m
+	}
+
 	// Make a new box view and switch state to handle it
-	m.boxView = NewBoxSelect(b)
-	m.state = boxView
+	m.save = sf
+	m.refreshSaveViews()
+	m.state = m.saveView()
 
 	return m
 }
@@ -191,11 +284,15 @@ func InitMainEditor(pk pokemon.PStructure) MainModel {
 			NewStatEditor(),
 			NewOriginEditor(),
 			NewRibbonEditor(),
+			NewNameEditor(),
+			NewHexEditor(),
 		},
 
 		searcher: NewSearchModel(),
 		picker:   makeFilePicker(),
 
+		history: newMonHistory(pk),
+
 		pks:  pk,
 		keys: &EditorKeys,
 		help: help.New(),
@@ -236,18 +333,61 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.height = msg.Height
 
 	case returnMsg:
+		m.recordLoad(msg.slot.String(), msg.pk, &msg.slot)
 		m.setMon(msg.pk)
 		m.updateEditors()
+		m.slot = &msg.slot
 		m.state = mailEdit
 
 		_, cmd := m.editors[mailEdit].Update(msg)
 		cmds = append(cmds, cmd)
 
+	// Box view shares the storage but caches its table
+	case storageChangedMsg:
+		if b, ok := m.boxView.(boxSelect); ok {
+			m.boxView = NewBoxSelect(m.save.storage, b.index)
+		}
+
 	case statusMsg:
 		m.status = msg.stat
 		cmds = append(cmds, clearStatus())
 
+	// Searches keep streaming to the mail editor while another view is up
+	case searchHitMsg, searchDoneMsg, searchTickMsg:
+		_, cmd := m.editors[mailEdit].Update(msg)
+		return m, cmd
+
+	case historyJumpMsg:
+		if e, ok := m.history.jump(msg.index); ok {
+			m.restore(e)
+			m.status = fmt.Sprintf("History %d: %s", msg.index, e.desc)
+			cmds = append(cmds, clearStatus())
+		}
+		m.state = mailEdit
+		return m, tea.Batch(cmds...)
+
+	// Templates aren't tied to any save slot
+	case templateMsg:
+		m.recordLoad("template "+msg.name, msg.pk, nil)
+		m.slot = nil
+		m.setMon(msg.pk)
+		m.updateEditors()
+		m.state = mailEdit
+		return m, func() tea.Msg { return updateMail{pk: msg.pk} }
+
+	case loadBlockMsg:
+		m.err = m.save.loadBlock(msg.backup)
+		m.slot = nil
+		m.refreshSaveViews()
+		return m, nil
+
 	case tea.KeyMsg:
+		// A focused text input gets the keys the hotkeys below would take,
+		// only quitting, writing and closing its view still go through
+		if m.inputFocused() && !key.Matches(msg, m.keys.Quit, m.keys.Write, m.closeKey()) {
+			break
+		}
+
 		switch {
 		case key.Matches(msg, m.keys.Quit):
 			return m, tea.Quit
@@ -264,8 +404,140 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				cmds = append(cmds, cmd)
 			}
 
+			if m.state == partyView {
+				_, cmd := m.partyView.Update(msg)
+				cmds = append(cmds, cmd)
+			}
+
+			if m.state == trainerView {
+				_, cmd := m.trainerView.Update(msg)
+				cmds = append(cmds, cmd)
+			}
+
+			if m.state == bagView {
+				m.bagView, _ = m.bagView.Update(msg)
+			}
+
+			if m.state == gridView {
+				m.gridView, _ = m.gridView.Update(msg)
+			}
+
+			if m.state == checkView {
+				m.checkView, _ = m.checkView.Update(msg)
+			}
+
+			if m.state == batchView {
+				m.batchView, _ = m.batchView.Update(msg)
+			}
+
+			if m.state == templateView {
+				m.templates, _ = m.templates.Update(msg)
+			}
+
+			if m.state == historyView {
+				m.historyView, _ = m.historyView.Update(msg)
+			}
+
+			if m.state == aceView {
+				m.aceView, _ = m.aceView.Update(msg)
+			}
+
 			return m, tea.Batch(cmds...)
 
+		// Undo names the entry it steps back over, redo the one it lands on
+		case key.Matches(msg, m.keys.Undo) && m.hasHistory():
+			desc := m.history.current().desc
+			e, ok := m.history.undo()
+			return m, m.stepHistory("Undo", desc, e, ok)
+
+		case key.Matches(msg, m.keys.Redo) && m.hasHistory():
+			e, ok := m.history.redo()
+			return m, m.stepHistory("Redo", e.desc, e, ok)
+
+		case key.Matches(msg, m.keys.History):
+			if m.state == historyView {
+				m.state = mailEdit
+			} else {
+				m.historyView = NewHistoryList(m.history)
+				m.state = historyView
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Gallery):
+			if m.state == templateView {
+				m.state = mailEdit
+			} else {
+				m.templates = NewTemplateList()
+				m.state = templateView
+			}
+			return m, nil
+
+		case key.Matches(msg, BoxKeys.Party) && m.hasBlock():
+			if m.state == partyView {
+				m.state = boxView
+			} else {
+				m.state = partyView
+			}
+			return m, nil
+
+		case key.Matches(msg, BoxKeys.Trainer) && m.hasBlock():
+			if m.state == trainerView {
+				m.state = boxView
+			} else {
+				m.state = trainerView
+			}
+			return m, nil
+
+		case key.Matches(msg, BoxKeys.Bag) && m.hasBlock():
+			if m.state == bagView {
+				m.state = boxView
+			} else {
+				m.state = bagView
+			}
+			return m, nil
+
+		case key.Matches(msg, BoxKeys.Check) && m.save != nil:
+			if m.state == checkView && m.hasBlock() {
+				m.state = boxView
+			} else {
+				m.state = checkView
+			}
+			return m, nil
+
+		case key.Matches(msg, BoxKeys.Batch) && m.hasBlock():
+			if m.state == batchView {
+				m.state = boxView
+			} else {
+				index := 0
+				if b, ok := m.boxView.(boxSelect); ok {
+					index = b.index
+				}
+				m.batchView = NewBatchMail(m.save.storage, index)
+				m.state = batchView
+			}
+			return m, nil
+
+		case key.Matches(msg, BoxKeys.Ace) && m.hasBlock():
+			if m.state == aceView {
+				m.state = boxView
+				return m, nil
+			}
+
+			// Coming back keeps whatever code was typed
+			if m.aceView == nil {
+				m.aceView = NewAceEditor(m.save.storage)
+			}
+			m.state = aceView
+			return m, m.aceView.Init()
+
+		case key.Matches(msg, BoxKeys.Grid) && m.hasBlock():
+			if m.state == gridView {
+				m.state = boxView
+			} else {
+				m.state = gridView
+			}
+			return m, nil
+
 		// Editor hotkeys
 		case key.Matches(msg, m.keys.Mail):
 			m.state = mailEdit
@@ -303,6 +575,14 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.state = statEdit
 			return m, nil
 
+		case key.Matches(msg, m.keys.Names):
+			m.state = nameEdit
+			return m, nil
+
+		case key.Matches(msg, m.keys.Hex):
+			m.state = hexEdit
+			return m, nil
+
 		case key.Matches(msg, m.keys.File):
 			m.state = picker
 
@@ -314,7 +594,8 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				},
 			)
 
-		case key.Matches(msg, m.keys.Save):
+		// Only the editors hold a mon to save
+		case key.Matches(msg, m.keys.Save) && m.state <= hexEdit:
 			m.setMon(m.editors[m.state].GetPokemon())
 
 			if m.state == mailEdit {
@@ -324,6 +605,22 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.editors[mailEdit].SetPokemon(m.pks)
 			m.status = "Saving active mon data.."
 			cmds = append(cmds, clearStatus())
+
+		case key.Matches(msg, m.keys.Write) && (m.state == bagView || m.state == gridView || m.state == boxView || m.state == aceView):
+			if err := m.writeSave(); err != nil {
+				m.status = fmt.Sprintf("Unable to write save: %v", err)
+			} else {
+				m.status = fmt.Sprintf("Wrote changes to %s", path.Base(m.save.path))
+			}
+			return m, clearStatus()
+
+		case key.Matches(msg, m.keys.Write):
+			if err := m.writeMonToSave(); err != nil {
+				m.status = fmt.Sprintf("Unable to write mon to save: %v", err)
+			} else {
+				m.status = fmt.Sprintf("Wrote mon to %s", path.Base(m.save.path))
+			}
+			return m, clearStatus()
 		}
 
 		switch msg.String() {
@@ -361,8 +658,10 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch m.state {
 
 	case mailEdit, growthEdit, miscEdit, attackEdit,
-		evscEdit, statEdit, originEdit, ribbonEdit:
+		evscEdit, statEdit, originEdit, ribbonEdit, nameEdit, hexEdit:
+		before := m.editors[m.state].GetPokemon()
 		_, cmd = m.editors[m.state].Update(msg)
+		m.recordEdit(msg, before)
 
 	case searcher:
 		_, cmd = m.searcher.Update(msg)
@@ -376,6 +675,8 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.selectedFile = p
 
 			f := func(pk pokemon.PStructure) {
+				m.recordLoad(path.Base(p), pk, nil)
+				m.slot = nil
 				m.setMon(pk)
 				m.editors[mailEdit].SetPokemon(m.pks)
 				m.updateEditors()
@@ -388,7 +689,7 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case ".pk3":
 				pk, err := save.GetRawMonDataFromFile(p)
 				if err != nil {
-					m.err = errors.New("unable to read .pk3 file")
+					m.err = fmt.Errorf("unable to read .pk3 file: %w", err)
 				} else {
 					mon := pokemon.GeneratePokemonFromRawData(pk, false, true)
 					f(mon)
@@ -397,18 +698,25 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case ".ek3":
 				pk, err := save.GetRawMonDataFromFile(p)
 				if err != nil {
-					m.err = errors.New("unable to read .ek3 file")
+					m.err = fmt.Errorf("unable to read .ek3 file: %w", err)
 				} else {
 					mon := pokemon.GeneratePokemonFromRawData(pk, false, false)
 					f(mon)
 				}
 
 			case ".sav", ".SAV":
-				m.state = boxView
-				sav := save.GenerateRawSaveData(p)
-				block := sav.GenerateSaveBlock()
+				sf, err := loadSaveFile(p)
+				m.err = err
+				if sf == nil {
+					cmds = append(cmds, clearErrorAfter(2*time.Second))
+					break
+				}
+
+				m.save = sf
+				m.slot = nil
 
-				m.boxView = NewBoxSelect(block.GetRawBoxData())
+				m.refreshSaveViews()
+				m.state = m.saveView()
 			}
 		}
 
@@ -423,6 +731,33 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case boxView:
 		m.boxView, cmd = m.boxView.Update(msg)
+
+	case partyView:
+		m.partyView, cmd = m.partyView.Update(msg)
+
+	case trainerView:
+		m.trainerView, cmd = m.trainerView.Update(msg)
+
+	case bagView:
+		m.bagView, cmd = m.bagView.Update(msg)
+
+	case gridView:
+		m.gridView, cmd = m.gridView.Update(msg)
+
+	case checkView:
+		m.checkView, cmd = m.checkView.Update(msg)
+
+	case batchView:
+		m.batchView, cmd = m.batchView.Update(msg)
+
+	case templateView:
+		m.templates, cmd = m.templates.Update(msg)
+
+	case historyView:
+		m.historyView, cmd = m.historyView.Update(msg)
+
+	case aceView:
+		m.aceView, cmd = m.aceView.Update(msg)
 	}
 
 	cmds = append(cmds, cmd)
@@ -465,15 +800,43 @@ func (m MainModel) View() string {
 		)
 		return t
 
+	case nameEdit, hexEdit:
+		r := m.editors[m.state].View()
+		return lipgloss.JoinVertical(
+			lipgloss.Center,
+			r,
+			m.editors[m.state].GetKeys(),
+			m.MakeHeader(w(r)),
+		)
+
 	case searcher:
 		return m.searcher.View()
 	case picker:
 		return m.makeFilePickerView()
 	case boxView:
-		return m.boxView.View()
+		return lipgloss.JoinVertical(lipgloss.Left, m.boxView.View(), m.status, m.makeErrorView())
+	case partyView:
+		return m.partyView.View()
+	case trainerView:
+		return m.trainerView.View()
+	case bagView:
+		return lipgloss.JoinVertical(lipgloss.Left, m.bagView.View(), m.status)
+	case gridView:
+		return lipgloss.JoinVertical(lipgloss.Left, m.gridView.View(), m.status)
+	case checkView:
+		return lipgloss.JoinVertical(lipgloss.Left, m.checkView.View(), m.makeErrorView())
+	case batchView:
+		return lipgloss.JoinVertical(lipgloss.Left, m.batchView.View(), m.status)
+	case templateView:
+		return lipgloss.JoinVertical(lipgloss.Left, m.templates.View(), m.status)
+	case historyView:
+		return lipgloss.JoinVertical(lipgloss.Left, m.historyView.View(), m.status)
+	case aceView:
+		return lipgloss.JoinVertical(lipgloss.Left, m.aceView.View(), m.status)
 	}
 
-	views := make([]string, len(m.editors)-1)
+	// Only the editors between mail and names share the grid
+	views := make([]string, ribbonEdit)
 	for i := range len(views) {
 		views[i] = m.editors[i+1].View()
 	}
@@ -506,6 +869,179 @@ func (m MainModel) View() string {
 	}
 }
 
+// Encrypts the active mon and writes it over the slot it was loaded from,
+// then saves the block over the backup save slot on disk
+func (m *MainModel) writeMonToSave() error {
+	if !m.hasBlock() || m.slot == nil {
+		return errNoSaveSlot
+	}
+
+	data, err := m.pks.ToEK3()
+	if err != nil {
+		return err
+	}
+
+	if m.slot.party {
+		err = m.save.block.SetPartyMon(m.slot.index, data)
+	} else {
+		err = m.save.storage.SetMonData(
+			boxes.MonLocation{Box: m.slot.box, Slot: m.slot.index}, data,
+		)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return m.writeSave()
+}
+
+// Writes the in memory save block over the backup save slot on disk
+func (m *MainModel) writeSave() error {
+	if !m.hasBlock() {
+		return errNoSaveBlock
+	}
+
+	if err := m.save.storage.WriteToSave(&m.save.block); err != nil {
+		return err
+	}
+
+	if err := m.save.raw.WriteSaveBlock(&m.save.block); err != nil {
+		return err
+	}
+
+	if err := m.save.raw.WriteToFile(m.save.path); err != nil {
+		return err
+	}
+
+	m.refreshSaveViews()
+	return nil
+}
+
+// inputFocused is true while the view has a text input taking keys
+func (m *MainModel) inputFocused() bool {
+	switch m.state {
+	case aceView, batchView:
+		return true
+	case gridView:
+		g, ok := m.gridView.(pcGrid)
+		return ok && g.filter.Focused()
+	}
+	return false
+}
+
+// The hotkey that closes the view with a focused text input
+func (m *MainModel) closeKey() key.Binding {
+	switch m.state {
+	case aceView:
+		return BoxKeys.Ace
+	case batchView:
+		return BoxKeys.Batch
+	}
+	return BoxKeys.Grid
+}
+
+// hasBlock is true once a save with a readable block is loaded, which
+// every save view but the integrity panel needs
+func (m *MainModel) hasBlock() bool {
+	return m.save != nil && m.save.loaded()
+}
+
+// The view a save opens in, the integrity panel when no block loaded
+func (m *MainModel) saveView() editorState {
+	if m.hasBlock() {
+		return boxView
+	}
+	return checkView
+}
+
+func (m *MainModel) refreshSaveViews() {
+	// Only fails on a wrong size save, which never gets this far
+	report, _ := m.save.raw.CheckIntegrity()
+
+	if !m.save.loaded() {
+		m.checkView = NewIntegrityPanel(report, noBlockLoaded)
+		return
+	}
+
+	m.boxView = NewBoxSelect(m.save.storage, 0)
+	m.gridView = NewPCGrid(m.save.storage)
+	m.partyView = NewPartySelect(m.save.block.GetRawTeamAndItems())
+
+	m.bagView = NewBagEditor(&m.save.block)
+
+	m.checkView = NewIntegrityPanel(report, m.save.block.GetOffset())
+
+	// The code typed into the ace editor outlives a reload
+	if a, ok := m.aceView.(aceEditor); ok {
+		a.pc = m.save.storage
+		m.aceView = a
+	}
+
+	info := m.save.block.GetTrainerInfo()
+	m.trainerView = NewTrainerCard(info)
+
+	if mail, ok := m.editors[mailEdit].(*MailEditor); ok {
+		mail.SetTrainer(&info)
+	}
+}
+
+func (m *MainModel) hasHistory() bool {
+	return m.state <= hexEdit || m.state == historyView
+}
+
+// Records the change an editor made to its copy of the mon, labelled by
+// the key that made it and the editor it was made in
+func (m *MainModel) recordEdit(msg tea.Msg, before pokemon.PStructure) {
+	after := m.editors[m.state].GetPokemon()
+	if reflect.DeepEqual(before, after) {
+		return
+	}
+
+	action := "Edit"
+	if k, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(k, m.keys.Commit):
+			action = "Commit"
+		case key.Matches(k, m.keys.Reset):
+			action = "Reset"
+		}
+	}
+
+	m.history.push(action+" "+edit[m.state], before, after, m.slot)
+}
+
+func (m *MainModel) recordLoad(from string, pk pokemon.PStructure, slot *monSlot) {
+	m.history.push("Load "+from, pk, pk, slot)
+}
+
+// Loads a history entry into every editor along with the slot the mon
+// would be written back to
+func (m *MainModel) restore(e historyEntry) {
+	m.setMon(e.pks)
+	m.slot = e.slot
+	m.updateEditors()
+
+	if mail, ok := m.editors[mailEdit].(*MailEditor); ok {
+		mail.SetNewPokemon(e.pks)
+	}
+}
+
+func (m *MainModel) stepHistory(action, desc string, e historyEntry, ok bool) tea.Cmd {
+	if !ok {
+		m.status = fmt.Sprintf("Nothing to %s", strings.ToLower(action))
+		return clearStatus()
+	}
+
+	m.restore(e)
+	if m.state == historyView {
+		m.historyView = NewHistoryList(m.history)
+	}
+
+	m.status = fmt.Sprintf("%s %s", action, desc)
+	return clearStatus()
+}
+
 func (m *MainModel) UpdateEditorValues() {
 	for i := range m.editors {
 		m.editors[i].UpdateValues()
@@ -549,7 +1085,7 @@ func (m *MainModel) makeFilePickerView() string {
 	var s strings.Builder
 	s.WriteString("\n  ")
 	if m.err != nil {
-		s.WriteString(m.picker.Styles.DisabledFile.Render(m.err.Error()))
+		s.WriteString(m.makeErrorView())
 	} else {
 		s.WriteString(m.picker.CurrentDirectory)
 	}
@@ -557,6 +1093,13 @@ func (m *MainModel) makeFilePickerView() string {
 	return s.String()
 }
 
+func (m *MainModel) makeErrorView() string {
+	if m.err == nil {
+		return ""
+	}
+	return m.picker.Styles.DisabledFile.Render(m.err.Error())
+}
+
 func subStructArray2String(s []byte) string {
 	str := new(strings.Builder)
 	for i := range s {
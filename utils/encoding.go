@@ -1,5 +1,7 @@
 package utils
 
+import "slices"
+
 // https://bulbapedia.bulbagarden.net/wiki/Character_encoding_(Generation_III)
 
 var westernCharsEnc = []rune{
@@ -40,97 +42,87 @@ var jpnCharEnc = []rune{
 	'：', 'Ä', 'Ö', 'Ü', 'ä', 'ö', 'ü', '⬆', '⬇', '⬅', ' ', ' ', ' ', ' ', ' ', ' ',
 }
 
-func findRuneIndex(r rune) int {
-	for i, rune := range westernCharsEnc {
-		if r == rune {
-			return i
-		}
-	}
-	return 0
-}
+const (
+	LangJapanese = 0x01
+	LangEnglish  = 0x02
 
-// This is pretty jank but it works for now
-func GenerateNickNameString(bs []byte) string {
-	if len(bs) > 10 {
-		return ""
-	}
+	// Japanese names only use the first five bytes of the field and are
+	// always terminated right after, the rest of the field is padding
+	JPNNameMaxLength = 5
 
-	out := make([]rune, 10)
+	nameTerminator = 0xFF
+)
 
-	for i, v := range bs {
-		if v == 0xFF {
-			out[i] = '\\'
-			break
-		} else {
-			out[i] = westernCharsEnc[v]
-		}
+func getCharTable(lang uint8) []rune {
+	if lang == LangJapanese {
+		return jpnCharEnc
 	}
-
-	return string(out)
+	return westernCharsEnc
 }
 
-func GenerateOTNameString(bs []byte) string {
-	if len(bs) > 7 {
-		return ""
+// NameMaxLength is how many characters the game allows for the language
+// in a name field of size bytes
+func NameMaxLength(size int, lang uint8) int {
+	if lang == LangJapanese {
+		return min(size, JPNNameMaxLength)
 	}
+	return size
+}
 
-	out := make([]rune, 7)
+// isMapped reports whether byte v decodes to its own character. The
+// blank spots in the tables and repeated glyphs can't be told apart
+// from text, so those bytes are escaped instead
+func isMapped(table []rune, v byte) bool {
+	r := table[v]
+	if v != 0 && r == ' ' {
+		return false
+	}
+	return slices.Index(table, r) == int(v)
+}
 
-	for i, v := range bs {
-		if v == 0xFF {
-			out[i] = '\\'
-			break
-		} else {
-			out[i] = westernCharsEnc[v]
+func lookupRune(table []rune, r rune) (byte, bool) {
+	for i, c := range table {
+		if c == r && isMapped(table, byte(i)) {
+			return byte(i), true
 		}
+	}
 
+	// Plain ASCII typed in for a Japanese name uses the full width forms
+	switch {
+	case r == ' ':
+		return lookupRune(table, '\u3000')
+	case r > ' ' && r <= '~':
+		return lookupRune(table, r+0xFEE0)
 	}
 
-	return string(out)
+	return 0, false
 }
 
-func GenerateNickNameSlice(s string) []byte {
-	r := []rune(s)
-
-	if len(r) > 10 {
-		return nil
-	}
-
-	out := make([]byte, 10)
+func findRuneIndex(table []rune, r rune) int {
+	b, _ := lookupRune(table, r)
+	return int(b)
+}
 
-	for i, v := range r {
-		if i == 10 {
-			break
-		}
-		// TODO: Add const vals for special rune here and length consts
-		if v == '\\' {
-			out[i] = 0xFF
-		} else {
-			c := findRuneIndex(v)
-			out[i] = byte(c)
-		}
+func GenerateNickNameString(bs []byte, lang uint8) string {
+	if len(bs) > 10 {
+		return ""
 	}
-	return out
+	return DecodeText(bs, lang)
 }
 
-func GenerateOTNameSlice(s string) []byte {
-	r := []rune(s)
-
-	if len(r) > 7 {
-		return nil
+func GenerateOTNameString(bs []byte, lang uint8) string {
+	if len(bs) > 7 {
+		return ""
 	}
+	return DecodeText(bs, lang)
+}
 
-	out := make([]byte, 7)
+func GenerateNickNameSlice(s string, lang uint8) ([]byte, error) {
+	return EncodeText(s, 10, lang)
+}
 
-	for i, v := range r {
-		if v == '\\' {
-			out[i] = 0xFF
-		} else {
-			c := findRuneIndex(v)
-			out[i] = byte(c)
-		}
-	}
-	return out
+func GenerateOTNameSlice(s string, lang uint8) ([]byte, error) {
+	return EncodeText(s, 7, lang)
 }
 
 func WesternSliceToString(bs []byte) string {
@@ -149,7 +141,7 @@ func WesternStringToByteSlice(s string) []byte {
 	out := []byte{}
 
 	for _, c := range s {
-		i := findRuneIndex(c)
+		i := findRuneIndex(westernCharsEnc, c)
 		out = append(out, byte(i))
 	}
 
@@ -172,10 +164,7 @@ func JPNStringToByteSlice(s string) []byte {
 	out := []byte{}
 
 	for _, c := range s {
-		out = append(
-			out,
-			byte(jpnCharEnc[c]),
-		)
+		out = append(out, byte(findRuneIndex(jpnCharEnc, c)))
 	}
 
 	return out
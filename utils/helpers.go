@@ -7,8 +7,23 @@ import (
 )
 
 var (
-	ErrOutOfRange          = fmt.Errorf("input value out of range")
-	ErrPKIncorrectFileSize = fmt.Errorf("pk* file does not match expected value")
+	ErrOutOfRange            = fmt.Errorf("input value out of range")
+	ErrPKIncorrectFileSize   = fmt.Errorf("pk* file does not match expected value")
+	ErrSaveIncorrectFileSize = fmt.Errorf("save file does not match expected size")
+	ErrFileNotFound          = fmt.Errorf("file does not exist")
+	ErrSaveTooShort          = fmt.Errorf("save file is shorter than expected")
+	ErrSaveBadSignature      = fmt.Errorf("save section signature is invalid")
+	ErrSaveBadSection        = fmt.Errorf("save section id is invalid")
+	ErrSaveChecksum          = fmt.Errorf("save section checksum does not match")
+	ErrNoSearchTarget        = fmt.Errorf("search needs at least one field target")
+	ErrBadTextEscape         = fmt.Errorf("text escape is malformed")
+	ErrBadTextChar           = fmt.Errorf("character has no gen 3 encoding")
+	ErrAsmSyntax             = fmt.Errorf("instruction is malformed")
+	ErrAsmTooLong            = fmt.Errorf("code does not fit in the box names")
+	ErrAsmUntypeable         = fmt.Errorf("byte can't be typed on the naming screen")
+	ErrROMNotGen3            = fmt.Errorf("rom is not a gen 3 pokemon game")
+	ErrROMTableNotFound      = fmt.Errorf("rom table could not be found")
+	ErrNoGrowthTable         = fmt.Errorf("growth rate has no experience table")
 )
 
 type UNumber interface {
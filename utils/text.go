@@ -0,0 +1,183 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Control codes the text engine acts on instead of drawing
+const (
+	TextPrompt      = 0xFA
+	TextParagraph   = 0xFB
+	TextExtCtrl     = 0xFC
+	TextPlaceholder = 0xFD
+	TextNewline     = 0xFE
+)
+
+// Bytes each extended control code takes, counting the sub code itself
+var extCtrlLengths = []int{
+	1, 2, 2, 2, 4, 2, 2, 1, 2, 1, 1, 3, 2,
+	2, 2, 1, 3, 2, 2, 2, 2, 1, 1, 1, 1,
+}
+
+// controlLength is how many bytes the control code at the start of bs
+// spans, 0 when it's not a control code
+func controlLength(bs []byte) int {
+	n := 0
+	switch bs[0] {
+	case TextPrompt, TextParagraph, TextNewline:
+		n = 1
+	case TextPlaceholder:
+		n = 2
+	case TextExtCtrl:
+		n = 2
+		if len(bs) > 1 && int(bs[1]) < len(extCtrlLengths) {
+			n = 1 + extCtrlLengths[bs[1]]
+		}
+	}
+
+	return min(n, len(bs))
+}
+
+func writeEscape(b *strings.Builder, bs []byte) {
+	b.WriteByte('[')
+	for i, v := range bs {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(b, "%02X", v)
+	}
+	b.WriteByte(']')
+}
+
+// DecodeText turns a name field into text that encodes back to the same
+// bytes. Control codes and bytes without a character of their own are
+// written as hex escapes like [FC 01 02], the terminator as \ and any
+// bytes after it other than the zero padding as one escape
+func DecodeText(bs []byte, lang uint8) string {
+	table := getCharTable(lang)
+	limit := NameMaxLength(len(bs), lang)
+	raw := func(v byte) bool {
+		return v != nameTerminator && !isMapped(table, v) && controlLength([]byte{v}) == 0
+	}
+
+	var b strings.Builder
+	i := 0
+
+	for i < limit && bs[i] != nameTerminator {
+		if n := controlLength(bs[i:]); n > 0 {
+			writeEscape(&b, bs[i:i+n])
+			i += n
+			continue
+		}
+
+		if raw(bs[i]) {
+			j := i + 1
+			for j < limit && raw(bs[j]) {
+				j++
+			}
+			writeEscape(&b, bs[i:j])
+			i = j
+			continue
+		}
+
+		b.WriteRune(table[bs[i]])
+		i++
+	}
+
+	rest := bs[i:]
+	switch {
+	case len(rest) == 0:
+	case rest[0] == nameTerminator:
+		b.WriteByte('\\')
+		if t := bytes.TrimRight(rest[1:], "\x00"); len(t) > 0 {
+			writeEscape(&b, t)
+		}
+	default:
+		// Whatever follows a full Japanese name without a terminator
+		writeEscape(&b, rest)
+	}
+
+	return b.String()
+}
+
+func parseEscape(s string) ([]byte, error) {
+	var out []byte
+	for _, f := range strings.Fields(s) {
+		v, err := strconv.ParseUint(f, 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrBadTextEscape, f)
+		}
+		out = append(out, byte(v))
+	}
+
+	if len(out) == 0 {
+		return nil, ErrBadTextEscape
+	}
+
+	return out, nil
+}
+
+// EncodeText is the reverse of DecodeText, padding the field to size
+// with zeros. Names shorter than the field get their terminator even when
+// it's left off, since 0x00 is a space and would show up after the name
+func EncodeText(s string, size int, lang uint8) ([]byte, error) {
+	table := getCharTable(lang)
+	rs := []rune(s)
+
+	var out []byte
+	chars := 0
+	terminated := false
+
+	for i := 0; i < len(rs); i++ {
+		switch r := rs[i]; r {
+		case '\\':
+			out = append(out, nameTerminator)
+			terminated = true
+
+		case '[':
+			end := i + 1
+			for end < len(rs) && rs[end] != ']' {
+				end++
+			}
+			if end == len(rs) {
+				return nil, ErrBadTextEscape
+			}
+
+			bs, err := parseEscape(string(rs[i+1 : end]))
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, bs...)
+			i = end
+
+		default:
+			v, ok := lookupRune(table, r)
+			if !ok {
+				return nil, fmt.Errorf("%w: %q", ErrBadTextChar, r)
+			}
+			if !terminated {
+				chars++
+			}
+			out = append(out, v)
+		}
+	}
+
+	if !terminated && len(out) < size && len(out) <= NameMaxLength(size, lang) {
+		out = append(out, nameTerminator)
+	}
+
+	if chars > NameMaxLength(size, lang) || len(out) > size {
+		return nil, ErrOutOfRange
+	}
+
+	return append(out, make([]byte, size-len(out))...), nil
+}
+
+// CleanText is the part of a decoded name the game shows
+func CleanText(s string) string {
+	s, _, _ = strings.Cut(s, "\\")
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,156 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestTextRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  []byte
+		lang uint8
+		text string
+	}{
+		{
+			name: "terminated nickname",
+			raw:  []byte{0xC7, 0xC3, 0xC7, 0xC3, 0xBF, 0xC8, 0xFF, 0x00, 0x00, 0x00},
+			lang: LangEnglish,
+			text: "MIMIEN\\",
+		},
+		{
+			name: "full nickname without terminator",
+			raw:  []byte{0xBB, 0xBC, 0xBD, 0xBE, 0xBF, 0xC0, 0xC1, 0xC2, 0xC3, 0xC4},
+			lang: LangEnglish,
+			text: "ABCDEFGHIJ",
+		},
+		{
+			name: "extended control code",
+			raw:  []byte{0xFC, 0x01, 0x02, 0xBB, 0xFF, 0x00, 0x00, 0x00, 0x00, 0x00},
+			lang: LangEnglish,
+			text: "[FC 01 02]A\\",
+		},
+		{
+			name: "bytes after the terminator",
+			raw:  []byte{0xBB, 0xFF, 0x00, 0x12, 0x00, 0x00, 0x00},
+			lang: LangEnglish,
+			text: "A\\[00 12]",
+		},
+		{
+			name: "empty name",
+			raw:  []byte{0xFF, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			lang: LangEnglish,
+			text: "\\",
+		},
+		{
+			name: "japanese five characters",
+			raw:  []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0xFF, 0x00, 0x00, 0x00, 0x00},
+			lang: LangJapanese,
+			text: "あいうえお\\",
+		},
+		{
+			name: "japanese without terminator",
+			raw:  []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x00, 0x00, 0x00},
+			lang: LangJapanese,
+			text: "あいうえお[06 07 00 00 00]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := DecodeText(tt.raw, tt.lang)
+			if s != tt.text {
+				t.Fatalf("DecodeText = %q, want %q", s, tt.text)
+			}
+
+			b, err := EncodeText(s, len(tt.raw), tt.lang)
+			if err != nil {
+				t.Fatalf("EncodeText(%q): %v", s, err)
+			}
+			if !bytes.Equal(b, tt.raw) {
+				t.Fatalf("EncodeText(%q) = % X, want % X", s, b, tt.raw)
+			}
+		})
+	}
+}
+
+func TestEncodeText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		size int
+		lang uint8
+		want []byte
+		err  error
+	}{
+		{
+			name: "japanese gets a terminator",
+			text: "あいう",
+			size: 10,
+			lang: LangJapanese,
+			want: []byte{0x01, 0x02, 0x03, 0xFF, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name: "western gets a terminator",
+			text: "HACK",
+			size: 9,
+			lang: LangEnglish,
+			want: []byte{0xC2, 0xBB, 0xBD, 0xC5, 0xFF, 0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name: "western filling the field",
+			text: "HACKHACKS",
+			size: 9,
+			lang: LangEnglish,
+			want: []byte{0xC2, 0xBB, 0xBD, 0xC5, 0xC2, 0xBB, 0xBD, 0xC5, 0xCD},
+		},
+		{
+			name: "japanese past five characters",
+			text: "あいうえおか",
+			size: 10,
+			lang: LangJapanese,
+			err:  ErrOutOfRange,
+		},
+		{
+			name: "too long for the field",
+			text: "ABCDEFGH",
+			size: 7,
+			lang: LangEnglish,
+			err:  ErrOutOfRange,
+		},
+		{
+			name: "unclosed escape",
+			text: "A[FC 01",
+			size: 10,
+			lang: LangEnglish,
+			err:  ErrBadTextEscape,
+		},
+		{
+			name: "escape that isn't hex",
+			text: "[ZZ]",
+			size: 10,
+			lang: LangEnglish,
+			err:  ErrBadTextEscape,
+		},
+		{
+			name: "character missing from the table",
+			text: "☃",
+			size: 10,
+			lang: LangEnglish,
+			err:  ErrBadTextChar,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := EncodeText(tt.text, tt.size, tt.lang)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("EncodeText(%q) error = %v, want %v", tt.text, err, tt.err)
+			}
+			if !bytes.Equal(b, tt.want) {
+				t.Fatalf("EncodeText(%q) = % X, want % X", tt.text, b, tt.want)
+			}
+		})
+	}
+}